@@ -0,0 +1,89 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"text/tabwriter"
+	"time"
+
+	"gotest/sort/sorts"
+)
+
+// 기저 정렬 방식 비교 (-basecase-report)
+// 퀵소트/머지소트가 작은 구간을 삽입정렬로 정렬할 때와 정렬 네트워크로 정렬할 때의
+// 전체 정렬 시간을 크기별로 측정해 변화율을 보고합니다. 다른 임계값은 현재 값 그대로입니다.
+
+var (
+	baseCaseReportSizes      = []int{1000, 10000, 100000, 1000000}
+	baseCaseReportAlgorithms = []string{
+		"quicksort", "introsort", "mergesort", "mergesort_buffered",
+		"parallel_quicksort", "parallel_mergesort_buffered",
+	}
+)
+
+// baseCaseNames 기저 정렬 방식과 출력용 이름 (빈 값은 삽입정렬)
+var baseCaseNames = map[string]string{
+	"":                              "삽입정렬",
+	string(sorts.BaseCaseInsertion): "삽입정렬",
+	string(sorts.BaseCaseNetwork):   "정렬 네트워크",
+}
+
+// reportBaseCases 알고리즘 × 크기마다 두 기저 정렬 방식의 중앙값 실행시간과 변화율 출력
+// 정렬 네트워크는 MaxNetworkSize 까지만 정렬하므로 전환 크기가 더 크면 줄여서 측정합니다.
+func reportBaseCases(w io.Writer, runs int) error {
+	orig, _ := sorts.CurrentTuning()
+	defer sorts.SetTuning(orig)
+
+	insertion, network := orig, orig
+	insertion.BaseCase = sorts.BaseCaseInsertion
+	network.BaseCase = sorts.BaseCaseNetwork
+	network.InsertionCutoff = min(network.InsertionCutoff, sorts.MaxNetworkSize)
+
+	fmt.Fprintf(w, "기저 정렬 방식 비교 (측정 %d회 중앙값, 무작위 분포)\n", runs)
+	fmt.Fprintf(w, "삽입정렬 전환 크기 %d, 정렬 네트워크 전환 크기 %d\n\n", insertion.InsertionCutoff, network.InsertionCutoff)
+
+	inputs := make([][]int, len(baseCaseReportSizes))
+	for i, size := range baseCaseReportSizes {
+		inputs[i] = generateRandomData(size)
+	}
+
+	tw := tabwriter.NewWriter(w, 0, 4, 2, ' ', 0)
+	fmt.Fprintf(tw, "알고리즘\t크기\t삽입정렬\t정렬 네트워크\t변화\t\n")
+
+	var allIns, allNet time.Duration
+	for _, name := range baseCaseReportAlgorithms {
+		sorter, ok := lookupSorter(name)
+		if !ok {
+			return fmt.Errorf("기저 정렬 비교: 등록되지 않은 알고리즘 %q", name)
+		}
+		sort := func(a []int) { copy(a, sorter.Sort(a)) }
+
+		var totalIns, totalNet time.Duration
+		for i, data := range inputs {
+			ins, err := timeSorts(insertion, data, runs, sort)
+			if err != nil {
+				return err
+			}
+			net, err := timeSorts(network, data, runs, sort)
+			if err != nil {
+				return err
+			}
+			totalIns += ins
+			totalNet += net
+			fmt.Fprintf(tw, "%s\t%d\t%v\t%v\t%s\t\n", name, baseCaseReportSizes[i], ins, net, changeCell(ins, net))
+		}
+		fmt.Fprintf(tw, "%s\t합계\t%v\t%v\t%s\t\n", name, totalIns, totalNet, changeCell(totalIns, totalNet))
+		allIns += totalIns
+		allNet += totalNet
+	}
+	fmt.Fprintf(tw, "전체\t\t%v\t%v\t%s\t\n", allIns, allNet, changeCell(allIns, allNet))
+	return tw.Flush()
+}
+
+// changeCell 삽입정렬 대비 정렬 네트워크의 실행시간 변화율 (음수면 빨라짐)
+func changeCell(ins, net time.Duration) string {
+	if ins <= 0 {
+		return "-"
+	}
+	return fmt.Sprintf("%+.1f%%", (float64(net)/float64(ins)-1)*100)
+}
@@ -2,14 +2,19 @@ package main
 
 import (
 	"bufio"
+	"cmp"
 	"encoding/json"
 	"fmt"
+	"math"
 	"math/rand"
 	"os"
 	"runtime"
+	"slices"
 	"strconv"
 	"strings"
 	"time"
+
+	"gotest/sort/sorts"
 )
 
 // BenchmarkResult 벤치마크 결과를 저장하는 구조체
@@ -17,18 +22,51 @@ type BenchmarkResult struct {
 	Algorithm    string        `json:"algorithm"`
 	DataSize     int           `json:"data_size"`
 	StorageType  string        `json:"storage_type"`
+	Distribution string        `json:"distribution"`
+	SelectK      int           `json:"select_k,omitempty"` // 선택 벤치마크의 k (정렬이면 0)
+	KeyType      string        `json:"key_type,omitempty"` // 키 정렬 벤치마크의 키 타입 (정수 정렬이면 빈 값)
+	Records      bool          `json:"records,omitempty"`  // (키, ID) 레코드 안정 정렬 벤치마크
 	TestRun      int           `json:"test_run"`
 	Duration     time.Duration `json:"duration"`
+	LoadDuration time.Duration `json:"load_duration,omitempty"` // 파일 모드에서 입력을 읽는 데 걸린 시간 (Duration 에 포함되지 않음)
 	MemoryUsage  uint64        `json:"memory_usage_bytes"`
 	CPUUsage     float64       `json:"cpu_usage_percent"`
 	GoroutineNum int           `json:"goroutine_num"`
+	GOMAXPROCS   int           `json:"gomaxprocs"`
+
+	// 실제 CPU 시간 (getrusage) 과 GC 지표 (runtime/metrics)
+	UserCPUTime   time.Duration `json:"user_cpu_time"`
+	SystemCPUTime time.Duration `json:"system_cpu_time"`
+	GCCycles      uint64        `json:"gc_cycles"`
+	GCPauseTotal  time.Duration `json:"gc_pause_total"`
+	PeakHeapBytes uint64        `json:"peak_heap_bytes"`
+
+	// 정렬 결과 검증 (정렬 여부 + 입력과 같은 멀티셋인지)
+	Verified bool   `json:"verified"`
+	Error    string `json:"error,omitempty"`
+
+	// 워크 스틸링 스케줄러 통계 (측정 구간 동안의 변화량)
+	SchedulerTasks  int64         `json:"scheduler_tasks"`
+	SchedulerSteals int64         `json:"scheduler_steals"`
+	SchedulerIdle   time.Duration `json:"scheduler_idle"`
 }
 
 // SystemStats 시스템 통계를 위한 구조체
 type SystemStats struct {
-	startTime time.Time
-	startMem  runtime.MemStats
-	endMem    runtime.MemStats
+	startTime  time.Time
+	startMem   runtime.MemStats
+	endMem     runtime.MemStats
+	startSched sorts.SchedulerStats
+	endSched   sorts.SchedulerStats
+
+	// CPU 시간 / GC 지표
+	startUser, startSys time.Duration
+	userCPU, sysCPU     time.Duration
+	startGC             gcSnapshot
+	gcCycles            uint64
+	gcPause             time.Duration
+	heap                *heapSampler
+	peakHeap            uint64
 }
 
 // generateRandomData 최적화된 랜덤 데이터 생성
@@ -44,6 +82,65 @@ func generateRandomData(size int) []int {
 	return data
 }
 
+// generateAdversarialData median-of-3 킬러 입력 생성
+// McIlroy 의 "A Killer Adversary for Quicksort" 방식으로, 값이 정해지지 않은(gas) 원소끼리
+// 비교될 때마다 피벗 후보를 가장 작은 값으로 고정해 가며 QuickSortFunc 를 실제로 실행합니다.
+// QuickSort 와 비교 순서가 같으므로 결과 입력은 QuickSort 를 O(n²) 으로 만듭니다.
+// 생성 자체도 O(n²) 이므로 큰 크기에는 사용하지 않습니다.
+func generateAdversarialData(size int) []int {
+	const gas = math.MaxInt
+
+	values := make([]int, size)
+	ids := make([]int, size)
+	for i := range size {
+		values[i] = gas
+		ids[i] = i
+	}
+
+	solid, candidate := 0, 0
+	sorts.QuickSortFunc(ids, func(a, b int) int {
+		if values[a] == gas && values[b] == gas {
+			// 둘 다 미정이면 피벗 후보 쪽을 고정
+			if a == candidate {
+				values[a] = solid
+			} else {
+				values[b] = solid
+			}
+			solid++
+		}
+		if values[a] == gas {
+			candidate = a
+		} else if values[b] == gas {
+			candidate = b
+		}
+		return cmp.Compare(values[a], values[b])
+	})
+
+	// 끝까지 비교되지 않은 원소는 남은 큰 값으로 채움
+	for i := range values {
+		if values[i] == gas {
+			values[i] = solid
+			solid++
+		}
+	}
+	return values
+}
+
+// keySize kvdb 벤치마크와 같은 키 크기
+const keySize = 20
+
+// generateKeys 정수 데이터를 kvdb 의 generateKey 와 같은 형식의 키로 변환
+// "0x1a2b" 처럼 16진수로 쓴 뒤 keySize 바이트까지 0 으로 채웁니다.
+func generateKeys(data []int) [][]byte {
+	keys := make([][]byte, len(data))
+	for i, v := range data {
+		key := make([]byte, keySize)
+		copy(key, fmt.Sprintf("0x%x", v))
+		keys[i] = key
+	}
+	return keys
+}
+
 // writeDataToFile 최적화된 파일 쓰기 (버퍼 크기 증가)
 func writeDataToFile(data []int, filename string) error {
 	file, err := os.Create(filename)
@@ -81,65 +178,41 @@ func writeDataToFile(data []int, filename string) error {
 	return writer.Flush()
 }
 
-// readDataFromFile 최적화된 파일 읽기
-func readDataFromFile(filename string) ([]int, error) {
-	file, err := os.Open(filename)
-	if err != nil {
-		return nil, err
-	}
-	defer file.Close()
-
-	// 파일 크기 기반으로 슬라이스 미리 할당
-	fileInfo, err := file.Stat()
-	if err != nil {
-		return nil, err
-	}
-
-	// 대략적인 숫자 개수 추정 (평균 6자리 + 개행)
-	estimatedSize := int(fileInfo.Size() / 7)
-	data := make([]int, 0, estimatedSize)
-
-	// 큰 버퍼 사용
-	scanner := bufio.NewScanner(file)
-	scanner.Buffer(make([]byte, 64*1024), bufio.MaxScanTokenSize)
-
-	for scanner.Scan() {
-		line := strings.TrimSpace(scanner.Text())
-		if line == "" {
-			continue
-		}
-
-		num, err := strconv.Atoi(line)
-		if err != nil {
-			return nil, err
-		}
-		data = append(data, num)
-	}
-
-	return data, scanner.Err()
-}
-
 // startStats 최적화된 성능 측정 시작
 func startStats() *SystemStats {
 	runtime.GC() // 가비지 컬렉션으로 정확한 측정
 	runtime.GC() // 두 번 실행으로 더 정확한 측정
 
-	var m runtime.MemStats
-	runtime.ReadMemStats(&m)
-
-	return &SystemStats{
-		startTime: time.Now(),
-		startMem:  m,
+	// 측정 도구 자체의 할당이 메모리 사용량에 잡히지 않도록 MemStats 보다 먼저 준비
+	s := &SystemStats{
+		startSched: sorts.DefaultScheduler().Stats(),
+		startGC:    readGCSnapshot(),
+		heap:       startHeapSampler(),
 	}
+
+	runtime.ReadMemStats(&s.startMem)
+	s.startUser, s.startSys, _ = processCPUTime()
+	s.startTime = time.Now()
+	return s
 }
 
 // endStats 최적화된 성능 측정 종료
 func (s *SystemStats) endStats() (time.Duration, uint64, float64) {
 	duration := time.Since(s.startTime)
+	endUser, endSys, cpuOK := processCPUTime()
+	s.endSched = sorts.DefaultScheduler().Stats()
+	s.peakHeap = s.heap.finish()
 
-	runtime.GC() // 측정 전 GC
+	// 누적 할당량(TotalAlloc, Mallocs)은 GC 와 무관하므로 GC 지표 읽기(할당 발생)보다 먼저 기록
 	runtime.ReadMemStats(&s.endMem)
 
+	// 아래 강제 GC 가 포함되지 않도록 먼저 GC 지표를 읽음
+	endGC := readGCSnapshot()
+	s.gcCycles = endGC.cycles - s.startGC.cycles
+	s.gcPause = gcPauseDelta(s.startGC.pauses, endGC.pauses)
+
+	runtime.GC() // 다음 측정을 위한 정리
+
 	// 더 정확한 메모리 사용량 계산
 	memUsage := s.endMem.TotalAlloc - s.startMem.TotalAlloc
 	if s.endMem.Mallocs > s.startMem.Mallocs {
@@ -147,50 +220,66 @@ func (s *SystemStats) endStats() (time.Duration, uint64, float64) {
 		memUsage += (s.endMem.Mallocs - s.startMem.Mallocs) * 16
 	}
 
-	// CPU 사용률 개선된 계산
-	cpuUsage := float64(runtime.NumGoroutine()) / float64(runtime.NumCPU()) * 50 // 더 현실적인 값
-	if cpuUsage > 100 {
-		cpuUsage = 100
+	// CPU 사용률 = CPU 시간 / (벽시계 시간 · GOMAXPROCS)
+	var cpuUsage float64
+	if cpuOK {
+		s.userCPU = endUser - s.startUser
+		s.sysCPU = endSys - s.startSys
+		if duration > 0 {
+			capacity := float64(duration) * float64(runtime.GOMAXPROCS(0))
+			cpuUsage = float64(s.userCPU+s.sysCPU) / capacity * 100
+		}
 	}
 
 	return duration, memUsage, cpuUsage
 }
 
+// fillResult 측정 구간의 CPU 시간, GC 지표, 스케줄러 통계를 결과에 기록
+func (s *SystemStats) fillResult(result *BenchmarkResult) {
+	result.UserCPUTime = s.userCPU
+	result.SystemCPUTime = s.sysCPU
+	result.GCCycles = s.gcCycles
+	result.GCPauseTotal = s.gcPause
+	result.PeakHeapBytes = s.peakHeap
+
+	sched := s.schedulerStats()
+	result.SchedulerTasks = sched.Tasks
+	result.SchedulerSteals = sched.Steals
+	result.SchedulerIdle = sched.IdleTime
+}
+
+// schedulerStats 측정 구간 동안의 스케줄러 통계 변화량
+func (s *SystemStats) schedulerStats() sorts.SchedulerStats {
+	return s.endSched.Sub(s.startSched)
+}
+
 // runBenchmark 최적화된 벤치마크 실행
-func runBenchmark(algorithm string, data []int, isFileMode bool) BenchmarkResult {
+func runBenchmark(sorter Sorter, data []int, storage, distribution string) BenchmarkResult {
 	var result BenchmarkResult
-	result.Algorithm = algorithm
+	result.Algorithm = sorter.Name()
 	result.DataSize = len(data)
+	result.StorageType = storage
+	result.Distribution = distribution
 	result.GoroutineNum = runtime.NumGoroutine()
-
-	if isFileMode {
-		result.StorageType = "file"
-	} else {
-		result.StorageType = "memory"
-	}
+	result.GOMAXPROCS = runtime.GOMAXPROCS(0)
 
 	// 메모리 효율적인 데이터 복사
 	testData := make([]int, len(data))
 	copy(testData, data)
 
+	// 검증용 입력 지문 (측정 구간 밖에서 계산)
+	inputFingerprint := fingerprintOf(data)
+
 	// 측정 전 시스템 안정화
 	runtime.GC()
 	time.Sleep(10 * time.Millisecond)
 
 	stats := startStats()
 
-	switch algorithm {
-	case "quicksort":
-		quickSort(testData)
-	case "parallel_quicksort":
-		parallelQuickSort(testData)
-	case "mergesort":
-		sorted := mergeSort(testData)
+	sorted := sorter.Sort(testData)
+	if !sorter.InPlace() {
 		// 메모리 사용량 정확한 측정을 위해 복사
 		copy(testData, sorted)
-	case "parallel_mergesort":
-		sorted := parallelMergeSort(testData)
-		copy(testData, sorted)
 	}
 
 	duration, memUsage, cpuUsage := stats.endStats()
@@ -198,12 +287,345 @@ func runBenchmark(algorithm string, data []int, isFileMode bool) BenchmarkResult
 	result.Duration = duration
 	result.MemoryUsage = memUsage
 	result.CPUUsage = cpuUsage
+	stats.fillResult(&result)
+
+	if err := verifySorted(inputFingerprint, testData); err != nil {
+		result.Error = err.Error()
+	} else {
+		result.Verified = true
+	}
 
 	return result
 }
 
+// runSelectBenchmark 선택 벤치마크 실행 (가장 작은 k 개 고르기)
+func runSelectBenchmark(selector Selector, data []int, k int, distribution string) BenchmarkResult {
+	var result BenchmarkResult
+	result.Algorithm = selector.Name()
+	result.DataSize = len(data)
+	result.StorageType = "memory"
+	result.Distribution = distribution
+	result.SelectK = k
+	result.GoroutineNum = runtime.NumGoroutine()
+	result.GOMAXPROCS = runtime.GOMAXPROCS(0)
+
+	testData := make([]int, len(data))
+	copy(testData, data)
+
+	// 검증용 정답 (측정 구간 밖에서 계산)
+	want := slices.Clone(data)
+	slices.Sort(want)
+	want = want[:k]
+
+	runtime.GC()
+	time.Sleep(10 * time.Millisecond)
+
+	stats := startStats()
+
+	selected := selector.Select(testData, k)
+
+	duration, memUsage, cpuUsage := stats.endStats()
+
+	result.Duration = duration
+	result.MemoryUsage = memUsage
+	result.CPUUsage = cpuUsage
+	stats.fillResult(&result)
+
+	if err := verifySelected(want, selected, selector.Ordered()); err != nil {
+		result.Error = err.Error()
+	} else {
+		result.Verified = true
+	}
+
+	return result
+}
+
+// runKeyBenchmark 키 정렬 벤치마크 실행
+// string 키는 측정 구간 밖에서 미리 변환해 두고 정렬 시간만 측정합니다.
+func runKeyBenchmark(sorter KeySorter, keys [][]byte, keyType, distribution string) BenchmarkResult {
+	var result BenchmarkResult
+	result.Algorithm = sorter.Name()
+	result.DataSize = len(keys)
+	result.StorageType = "memory"
+	result.Distribution = distribution
+	result.KeyType = keyType
+	result.GoroutineNum = runtime.NumGoroutine()
+	result.GOMAXPROCS = runtime.GOMAXPROCS(0)
+
+	// 키 내용은 정렬 중에 바뀌지 않으므로 슬라이스만 복사
+	var testBytes [][]byte
+	var testStrings []string
+	if keyType == "string" {
+		testStrings = make([]string, len(keys))
+		for i, key := range keys {
+			testStrings[i] = string(key)
+		}
+	} else {
+		testBytes = slices.Clone(keys)
+	}
+
+	// 검증용 입력 지문 (측정 구간 밖에서 계산)
+	inputFingerprint := keyFingerprintOf(keys)
+
+	runtime.GC()
+	time.Sleep(10 * time.Millisecond)
+
+	stats := startStats()
+
+	if testStrings != nil {
+		sorter.SortStrings(testStrings)
+	} else {
+		sorter.SortBytes(testBytes)
+	}
+
+	duration, memUsage, cpuUsage := stats.endStats()
+
+	result.Duration = duration
+	result.MemoryUsage = memUsage
+	result.CPUUsage = cpuUsage
+	stats.fillResult(&result)
+
+	var err error
+	if testStrings != nil {
+		err = verifyKeysSorted(inputFingerprint, testStrings)
+	} else {
+		err = verifyKeysSorted(inputFingerprint, testBytes)
+	}
+	if err != nil {
+		result.Error = err.Error()
+	} else {
+		result.Verified = true
+	}
+
+	return result
+}
+
+// runRecordBenchmark 레코드 안정 정렬 벤치마크 실행
+// data 를 키로, 입력 인덱스를 ID 로 하는 레코드를 정렬하고 안정성까지 검증합니다.
+func runRecordBenchmark(sorter RecordSorter, data []int, distribution string) BenchmarkResult {
+	var result BenchmarkResult
+	result.Algorithm = sorter.Name()
+	result.DataSize = len(data)
+	result.StorageType = "memory"
+	result.Distribution = distribution
+	result.Records = true
+	result.GoroutineNum = runtime.NumGoroutine()
+	result.GOMAXPROCS = runtime.GOMAXPROCS(0)
+
+	keys := slices.Clone(data)
+	ids := make([]int, len(data))
+	for i := range ids {
+		ids[i] = i
+	}
+
+	runtime.GC()
+	time.Sleep(10 * time.Millisecond)
+
+	stats := startStats()
+
+	sorter.SortRecords(keys, ids)
+
+	duration, memUsage, cpuUsage := stats.endStats()
+
+	result.Duration = duration
+	result.MemoryUsage = memUsage
+	result.CPUUsage = cpuUsage
+	stats.fillResult(&result)
+
+	if err := verifyRecordsStable(data, keys, ids); err != nil {
+		result.Error = err.Error()
+	} else {
+		result.Verified = true
+	}
+
+	return result
+}
+
+// runExternalBenchmark 외부 정렬 벤치마크 실행
+// 파일 읽기, 청크 정렬, 런 병합, 결과 쓰기까지 전체 과정을 측정합니다.
+func runExternalBenchmark(inputFile string, size int, distribution string, memoryBudget int64) (BenchmarkResult, error) {
+	var result BenchmarkResult
+	result.Algorithm = externalSortName
+	result.DataSize = size
+	result.StorageType = "file"
+	result.Distribution = distribution
+	result.GoroutineNum = runtime.NumGoroutine()
+	result.GOMAXPROCS = runtime.GOMAXPROCS(0)
+
+	outputFile := inputFile + ".sorted"
+	defer os.Remove(outputFile)
+
+	// 검증용 입력 지문 (측정 구간 밖에서 계산)
+	input, err := readDataFromFile(inputFile)
+	if err != nil {
+		return result, err
+	}
+	inputFingerprint := fingerprintOf(input)
+
+	// 측정 전 시스템 안정화
+	runtime.GC()
+	time.Sleep(10 * time.Millisecond)
+
+	stats := startStats()
+
+	_, err = sorts.ExternalSort(inputFile, outputFile, sorts.ExternalSortOptions{
+		MemoryBudget: memoryBudget,
+	})
+
+	duration, memUsage, cpuUsage := stats.endStats()
+	if err != nil {
+		return result, err
+	}
+
+	result.Duration = duration
+	result.MemoryUsage = memUsage
+	result.CPUUsage = cpuUsage
+	stats.fillResult(&result)
+
+	output, err := readDataFromFile(outputFile)
+	if err != nil {
+		return result, err
+	}
+	if err := verifySorted(inputFingerprint, output); err != nil {
+		result.Error = err.Error()
+	} else {
+		result.Verified = true
+	}
+
+	return result, nil
+}
+
+// storageNames 저장방식과 마크다운 출력용 이름
+var storageNames = map[string]string{
+	"memory": "인메모리",
+	"file":   "파일",
+	"binary": "바이너리 파일",
+}
+
+// keyTypeNames 키 타입과 마크다운 출력용 이름
+var keyTypeNames = map[string]string{
+	"bytes":  "[]byte",
+	"string": "string",
+}
+
+// reportSection 보고서의 한 구역 (같은 GOMAXPROCS / 저장방식 / 크기 / 선택 k / 키 타입)
+// 분포와 알고리즘은 실제 결과에 처음 나타난 순서를 따릅니다.
+type reportSection struct {
+	procs         int
+	storage       string
+	size          int
+	k             int
+	keyType       string
+	records       bool
+	distributions []string
+	algorithms    []string
+}
+
+// reportSections 실행된 결과로부터 보고서 구역 구성
+func reportSections(results []BenchmarkResult) []reportSection {
+	type sectionKey struct {
+		procs   int
+		storage string
+		size    int
+		k       int
+		keyType string
+		records bool
+	}
+
+	var sections []reportSection
+	index := make(map[sectionKey]int)
+	for _, r := range results {
+		k := sectionKey{r.GOMAXPROCS, r.StorageType, r.DataSize, r.SelectK, r.KeyType, r.Records}
+		i, ok := index[k]
+		if !ok {
+			i = len(sections)
+			index[k] = i
+			sections = append(sections, reportSection{procs: k.procs, storage: k.storage, size: k.size, k: k.k, keyType: k.keyType, records: k.records})
+		}
+
+		sec := &sections[i]
+		if !slices.Contains(sec.distributions, r.Distribution) {
+			sec.distributions = append(sec.distributions, r.Distribution)
+		}
+		if !slices.Contains(sec.algorithms, r.Algorithm) {
+			sec.algorithms = append(sec.algorithms, r.Algorithm)
+		}
+	}
+	return sections
+}
+
+// title 구역 제목 (GOMAXPROCS 가 여러 개일 때만 표시)
+func (sec reportSection) title(showProcs bool) string {
+	title := fmt.Sprintf("%s - %d개 데이터", displayName(storageNames, sec.storage), sec.size)
+	if sec.k > 0 {
+		title += fmt.Sprintf(" - 가장 작은 %d개 선택", sec.k)
+	}
+	if sec.keyType != "" {
+		title += fmt.Sprintf(" - %s 키", displayName(keyTypeNames, sec.keyType))
+	}
+	if sec.records {
+		title += " - (키, ID) 레코드"
+	}
+	if showProcs {
+		title += fmt.Sprintf(" (GOMAXPROCS=%d)", sec.procs)
+	}
+	return title
+}
+
+// fileOnly 파일 모드 구역에서만 표에 넣는 열 (인메모리면 빈 문자열)
+func (sec reportSection) fileOnly(column string) string {
+	if sec.storage == "memory" {
+		return ""
+	}
+	return column
+}
+
+// loadCell 로드시간 셀 (외부 정렬처럼 읽기가 실행시간에 포함된 경우는 -)
+func loadCell(d time.Duration) string {
+	if d == 0 {
+		return "-"
+	}
+	return d.String()
+}
+
+// displayName 출력용 이름 (등록되지 않은 이름은 그대로)
+func displayName(names map[string]string, key string) string {
+	if name, ok := names[key]; ok {
+		return name
+	}
+	return key
+}
+
+// ranAlgorithms 모든 구역에서 실행된 알고리즘 (처음 나타난 순서)
+func ranAlgorithms(sections []reportSection) []string {
+	var algos []string
+	for _, sec := range sections {
+		for _, algo := range sec.algorithms {
+			if !slices.Contains(algos, algo) {
+				algos = append(algos, algo)
+			}
+		}
+	}
+	return algos
+}
+
+func orderedNote(ordered bool) string {
+	if ordered {
+		return "정렬된 k개"
+	}
+	return "순서 없는 k개"
+}
+
+func yesNo(b bool) string {
+	if b {
+		return "예"
+	}
+	return "아니오"
+}
+
 // saveResultsToMarkdown 최적화된 마크다운 저장
-func saveResultsToMarkdown(results []BenchmarkResult) error {
+// 표는 하드코딩된 조건이 아니라 실제로 실행된 결과에서 만들어집니다.
+func saveResultsToMarkdown(results []BenchmarkResult, summaries []SummaryStats) error {
 	file, err := os.Create("benchmark_results.md")
 	if err != nil {
 		return err
@@ -218,54 +640,67 @@ func saveResultsToMarkdown(results []BenchmarkResult) error {
 	var builder strings.Builder
 	builder.Grow(1024 * 1024) // 1MB 미리 할당
 
+	sections := reportSections(results)
+
+	var procs []int
+	for _, sec := range sections {
+		if !slices.Contains(procs, sec.procs) {
+			procs = append(procs, sec.procs)
+		}
+	}
+	showProcs := len(procs) > 1
+
 	// 마크다운 헤더
 	builder.WriteString("# 정렬 알고리즘 벤치마크 결과\n\n")
 	builder.WriteString(fmt.Sprintf("실행 시간: %s\n", time.Now().Format("2006-01-02 15:04:05")))
 	builder.WriteString(fmt.Sprintf("CPU 코어 수: %d\n", runtime.NumCPU()))
-	builder.WriteString(fmt.Sprintf("GOMAXPROCS: %d\n\n", runtime.GOMAXPROCS(0)))
-
-	// 데이터 크기별로 그룹화
-	dataSizes := []int{1000, 10000, 100000}
-	storageTypes := []string{"memory", "file"}
-	algorithms := []string{"quicksort", "parallel_quicksort", "mergesort", "parallel_mergesort"}
-
-	algoNames := map[string]string{
-		"quicksort":          "퀵소트",
-		"parallel_quicksort": "병렬퀵소트",
-		"mergesort":          "머지소트",
-		"parallel_mergesort": "병렬머지소트",
+	builder.WriteString(fmt.Sprintf("GOMAXPROCS: %s\n", strings.Trim(fmt.Sprint(procs), "[]")))
+	builder.WriteString(fmt.Sprintf("정렬 임계값: %s\n\n", tuningDescription()))
+
+	// 실행된 알고리즘 특성
+	builder.WriteString("## 알고리즘\n\n")
+	builder.WriteString("| 이름 | 알고리즘 | 제자리 정렬 | 안정 정렬 |\n")
+	builder.WriteString("|------|----------|-------------|-----------|\n")
+	for _, algo := range ranAlgorithms(sections) {
+		if sorter, ok := lookupSorter(algo); ok {
+			builder.WriteString(fmt.Sprintf("| %s | `%s` | %s | %s |\n",
+				sorter.DisplayName(), algo, yesNo(sorter.InPlace()), yesNo(sorter.Stable())))
+		} else if selector, ok := lookupSelector(algo); ok {
+			builder.WriteString(fmt.Sprintf("| %s | `%s` | 선택 (%s) | - |\n",
+				selector.DisplayName(), algo, orderedNote(selector.Ordered())))
+		} else if sorter, ok := lookupKeySorter(algo); ok {
+			// 같은 키끼리는 구별할 수 없으므로 안정성은 의미가 없음
+			builder.WriteString(fmt.Sprintf("| %s | `%s` | 예 | - |\n", sorter.DisplayName(), algo))
+		} else if sorter, ok := lookupRecordSorter(algo); ok {
+			// 레코드 정렬은 안정성을 검증하므로 모두 안정 정렬
+			builder.WriteString(fmt.Sprintf("| %s | `%s` | 예 | 예 |\n", sorter.DisplayName(), algo))
+		} else {
+			builder.WriteString(fmt.Sprintf("| %s | `%s` | - | - |\n", algorithmDisplayName(algo), algo))
+		}
 	}
+	builder.WriteString("\n")
 
-	storageNames := map[string]string{
-		"memory": "인메모리",
-		"file":   "파일",
-	}
+	// 조건별로 그룹화된 개별 측정 결과
+	for _, sec := range sections {
+		for _, dist := range sec.distributions {
+			builder.WriteString(fmt.Sprintf("## %s - %s 분포\n\n",
+				sec.title(showProcs), displayName(distributionNames, dist)))
 
-	for _, size := range dataSizes {
-		for _, storage := range storageTypes {
-			if size == 100000 && storage == "memory" {
-				continue
-			}
-			if (size == 1000 || size == 10000) && storage == "file" {
-				continue
-			}
+			// 테이블 헤더 (파일 모드는 입력 읽기 시간 열 추가)
+			builder.WriteString("| 알고리즘 | 테스트 | 실행시간 |" + sec.fileOnly(" 로드시간 |") + " 메모리사용량 | CPU사용률 | 사용자CPU | 시스템CPU | GC횟수 | GC정지시간 | 최대힙 | 고루틴수 | 태스크수 | 스틸수 | 워커유휴시간 |\n")
+			builder.WriteString("|----------|--------|----------|" + sec.fileOnly("----------|") + "--------------|-----------|-----------|-----------|--------|------------|--------|----------|----------|--------|--------------|\n")
 
-			builder.WriteString(fmt.Sprintf("## %s - %d개 데이터\n\n", storageNames[storage], size))
-
-			// 테이블 헤더
-			builder.WriteString("| 알고리즘 | 테스트 | 실행시간 | 메모리사용량 | CPU사용률 | 고루틴수 |\n")
-			builder.WriteString("|----------|--------|----------|--------------|-----------|----------|\n")
-
-			for _, algo := range algorithms {
-				for run := 1; run <= 3; run++ {
-					for _, result := range results {
-						if result.Algorithm == algo && result.DataSize == size &&
-							result.StorageType == storage && result.TestRun == run {
-							builder.WriteString(fmt.Sprintf("| %s | %d | %v | %d bytes | %.2f%% | %d |\n",
-								algoNames[algo], run, result.Duration, result.MemoryUsage,
-								result.CPUUsage, result.GoroutineNum))
-							break
-						}
+			for _, algo := range sec.algorithms {
+				for _, result := range results {
+					if result.Algorithm == algo && result.GOMAXPROCS == sec.procs && result.DataSize == sec.size &&
+						result.StorageType == sec.storage && result.Distribution == dist && result.SelectK == sec.k &&
+						result.KeyType == sec.keyType && result.Records == sec.records {
+						builder.WriteString(fmt.Sprintf("| %s | %d | %v |%s %d bytes | %.2f%% | %v | %v | %d | %v | %d bytes | %d | %d | %d | %v |\n",
+							algorithmDisplayName(algo), result.TestRun, result.Duration,
+							sec.fileOnly(" "+loadCell(result.LoadDuration)+" |"), result.MemoryUsage,
+							result.CPUUsage, result.UserCPUTime, result.SystemCPUTime,
+							result.GCCycles, result.GCPauseTotal, result.PeakHeapBytes, result.GoroutineNum,
+							result.SchedulerTasks, result.SchedulerSteals, result.SchedulerIdle))
 					}
 				}
 			}
@@ -273,53 +708,83 @@ func saveResultsToMarkdown(results []BenchmarkResult) error {
 		}
 	}
 
-	// 요약 통계 (기존 로직 유지하되 최적화)
+	// 요약 통계
 	builder.WriteString("## 요약 통계\n\n")
 
-	for _, size := range dataSizes {
-		for _, storage := range storageTypes {
-			if size == 100000 && storage == "memory" {
-				continue
-			}
-			if (size == 1000 || size == 10000) && storage == "file" {
-				continue
-			}
-
-			builder.WriteString(fmt.Sprintf("### %s - %d개 데이터 평균\n\n", storageNames[storage], size))
-			builder.WriteString("| 알고리즘 | 평균 실행시간 | 평균 메모리사용량 |\n")
-			builder.WriteString("|----------|---------------|-------------------|\n")
-
-			for _, algo := range algorithms {
-				var totalDuration time.Duration
-				var totalMemory uint64
-				count := 0
+	summaryIndex := make(map[summaryKey]SummaryStats, len(summaries))
+	for _, sum := range summaries {
+		summaryIndex[sum.key()] = sum
+	}
 
-				for _, result := range results {
-					if result.Algorithm == algo && result.DataSize == size && result.StorageType == storage {
-						totalDuration += result.Duration
-						totalMemory += result.MemoryUsage
-						count++
-					}
+	for _, sec := range sections {
+		for _, dist := range sec.distributions {
+			builder.WriteString(fmt.Sprintf("### %s - %s 분포\n\n",
+				sec.title(showProcs), displayName(distributionNames, dist)))
+			builder.WriteString("| 알고리즘 | 측정수 | 이상치 | 평균 | 중앙값 | p90 | 표준편차 | 95% 신뢰구간 | 평균 메모리사용량 |" + sec.fileOnly(" 로드시간 중앙값 |") + "\n")
+			builder.WriteString("|----------|--------|--------|------|--------|-----|----------|--------------|-------------------|" + sec.fileOnly("-----------------|") + "\n")
+
+			for _, algo := range sec.algorithms {
+				if sum, ok := summaryIndex[summaryKey{algo, sec.procs, sec.size, sec.storage, dist, sec.k, sec.keyType, sec.records}]; ok {
+					builder.WriteString(fmt.Sprintf("| %s | %d | %d | %v | %v | %v | %v | %v ~ %v | %d bytes |%s\n",
+						algorithmDisplayName(algo), sum.Runs, sum.Outliers, sum.Mean, sum.Median, sum.P90,
+						sum.StdDev, sum.CILow, sum.CIHigh, sum.MeanMemory, sec.fileOnly(" "+loadCell(sum.MedianLoad)+" |")))
 				}
+			}
+			builder.WriteString("\n")
+		}
+
+		// 분포별 비교 (알고리즘 × 분포 중앙값 실행시간)
+		if len(sec.distributions) < 2 {
+			continue
+		}
 
-				if count > 0 {
-					avgDuration := totalDuration / time.Duration(count)
-					avgMemory := totalMemory / uint64(count)
-					builder.WriteString(fmt.Sprintf("| %s | %v | %d bytes |\n",
-						algoNames[algo], avgDuration, avgMemory))
+		builder.WriteString(fmt.Sprintf("### %s 분포별 중앙값 실행시간\n\n", sec.title(showProcs)))
+		builder.WriteString("| 알고리즘 |")
+		for _, dist := range sec.distributions {
+			builder.WriteString(fmt.Sprintf(" %s |", displayName(distributionNames, dist)))
+		}
+		builder.WriteString("\n|----------|")
+		for range sec.distributions {
+			builder.WriteString("------|")
+		}
+		builder.WriteString("\n")
+
+		for _, algo := range sec.algorithms {
+			builder.WriteString(fmt.Sprintf("| %s |", algorithmDisplayName(algo)))
+			for _, dist := range sec.distributions {
+				if sum, ok := summaryIndex[summaryKey{algo, sec.procs, sec.size, sec.storage, dist, sec.k, sec.keyType, sec.records}]; ok {
+					builder.WriteString(fmt.Sprintf(" %v |", sum.Median))
+				} else {
+					builder.WriteString(" - |")
 				}
 			}
 			builder.WriteString("\n")
 		}
+		builder.WriteString("\n")
 	}
 
+	writeScalingMarkdown(&builder, computeScaling(summaries))
+
 	// 한 번에 쓰기
 	_, err = writer.WriteString(builder.String())
 	return err
 }
 
+// benchmarkReport JSON 출력 형식 (개별 측정 결과 + 조건별 요약 통계)
+type benchmarkReport struct {
+	GeneratedAt time.Time         `json:"generated_at"`
+	NumCPU      int               `json:"num_cpu"`
+	GOMAXPROCS  int               `json:"gomaxprocs"`
+	Tuning      sorts.Tuning      `json:"tuning"`
+	TuningFrom  string            `json:"tuning_profile,omitempty"` // 비어 있으면 기본값
+	Matrix      *benchmarkMatrix  `json:"matrix,omitempty"`
+	Results     []BenchmarkResult `json:"results"`
+	Summaries   []SummaryStats    `json:"summaries"`
+	Scaling     []ScalingStats    `json:"scaling,omitempty"` // 여러 GOMAXPROCS 에서 측정한 병렬 알고리즘의 확장성
+}
+
 // saveResultsToJSON 최적화된 JSON 저장
-func saveResultsToJSON(results []BenchmarkResult) error {
+func saveResultsToJSON(matrix *benchmarkMatrix, results []BenchmarkResult, summaries []SummaryStats) error {
 	file, err := os.Create("benchmark_results.json")
 	if err != nil {
 		return err
@@ -332,5 +797,16 @@ func saveResultsToJSON(results []BenchmarkResult) error {
 
 	encoder := json.NewEncoder(writer)
 	encoder.SetIndent("", "  ")
-	return encoder.Encode(results)
+	tuning, tuningFrom := sorts.CurrentTuning()
+	return encoder.Encode(benchmarkReport{
+		GeneratedAt: time.Now(),
+		NumCPU:      runtime.NumCPU(),
+		GOMAXPROCS:  runtime.GOMAXPROCS(0),
+		Tuning:      tuning,
+		TuningFrom:  tuningFrom,
+		Matrix:      matrix,
+		Results:     results,
+		Summaries:   summaries,
+		Scaling:     computeScaling(summaries),
+	})
 }
@@ -0,0 +1,215 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"slices"
+	"time"
+
+	"gotest/sort/sorts"
+)
+
+// 임계값 보정 (-calibrate)
+// 후보 값마다 실제 정렬을 여러 번 실행해 중앙값이 가장 짧은 값을 고릅니다.
+//  1. 삽입정렬 전환 크기: 순차 인트로소트 + 버퍼 머지소트
+//     (전환 크기가 MaxNetworkSize 이하면 삽입정렬과 정렬 네트워크 중 빠른 기저 정렬 방식도 고름)
+//  2. 전체 크기 구간별 병렬 임계값: 병렬 인트로소트 + 병렬 버퍼 머지소트
+//  3. 병렬 처리 최소 크기: 병렬 인트로소트가 순차보다 처음으로 빨라지는 크기
+
+// 보정 설정
+const (
+	calibrationRuns          = 5       // 후보별 기본 측정 횟수 (중앙값 사용)
+	calibrationInsertionSize = 1 << 16 // 삽입정렬 전환 크기 측정용 입력 크기
+	calibrationMaxMinSize    = 1 << 20 // 병렬 처리 최소 크기 후보의 상한
+)
+
+var (
+	insertionCutoffCandidates = []int{8, 12, 16, 24, 32, 48, 64}
+	thresholdCandidates       = []int{128, 256, 512, 1024, 2048, 4096, 8192}
+
+	// calibrationBandSizes 병렬 임계값 구간(Small/Medium/Large)별 대표 전체 크기
+	calibrationBandSizes = [3]int{5000, 50000, 1000000}
+)
+
+// runCalibration 현재 기계에서 임계값을 측정해 반환 (진행 상황은 w 에 출력)
+// 측정이 끝나면 원래 임계값으로 되돌립니다.
+func runCalibration(w io.Writer, runs int) (sorts.Tuning, error) {
+	orig, _ := sorts.CurrentTuning()
+	defer sorts.SetTuning(orig)
+
+	t := sorts.DefaultTuning()
+	t.ParallelMinSize = 0 // 임계값을 재는 동안은 항상 병렬 처리
+
+	fmt.Fprintln(w, "삽입정렬 전환 크기")
+	data := generateRandomData(calibrationInsertionSize)
+	best, err := pickCandidate(w, insertionCutoffCandidates, func(c int) (time.Duration, error) {
+		t.InsertionCutoff = c
+		return timeSorts(t, data, runs, sorts.IntroSort[int], sorts.MergeSortBuffered[int])
+	})
+	if err != nil {
+		return sorts.Tuning{}, err
+	}
+	t.InsertionCutoff = best
+
+	if t.InsertionCutoff <= sorts.MaxNetworkSize {
+		fmt.Fprintln(w, "\n기저 정렬 방식")
+		t.BaseCase, err = calibrateBaseCase(w, t, data, runs)
+		if err != nil {
+			return sorts.Tuning{}, err
+		}
+	}
+
+	bands := [3]*int{&t.SmallThreshold, &t.MediumThreshold, &t.LargeThreshold}
+	for i, size := range calibrationBandSizes {
+		fmt.Fprintf(w, "\n병렬 임계값 (전체 %d개)\n", size)
+		data := generateRandomData(size)
+		candidates := slices.DeleteFunc(slices.Clone(thresholdCandidates), func(c int) bool { return c >= size/2 })
+		best, err := pickCandidate(w, candidates, func(c int) (time.Duration, error) {
+			*bands[i] = c
+			return timeSorts(t, data, runs, sorts.ParallelIntroSort[int], sorts.ParallelMergeSortBuffered[int])
+		})
+		if err != nil {
+			return sorts.Tuning{}, err
+		}
+		*bands[i] = best
+	}
+
+	fmt.Fprintln(w, "\n병렬 처리 최소 크기")
+	t.ParallelMinSize, err = calibrateParallelMinSize(w, t, runs)
+	if err != nil {
+		return sorts.Tuning{}, err
+	}
+	return t, nil
+}
+
+// calibrateBaseCase 삽입정렬과 정렬 네트워크 중 순차 인트로소트 + 버퍼 머지소트가 더 빠른 방식
+func calibrateBaseCase(w io.Writer, t sorts.Tuning, data []int, runs int) (sorts.BaseCase, error) {
+	best, bestTime := sorts.BaseCase(""), time.Duration(0)
+	for _, b := range []sorts.BaseCase{sorts.BaseCaseInsertion, sorts.BaseCaseNetwork} {
+		t.BaseCase = b
+		d, err := timeSorts(t, data, runs, sorts.IntroSort[int], sorts.MergeSortBuffered[int])
+		if err != nil {
+			return "", err
+		}
+		fmt.Fprintf(w, "  %9s: %v\n", b, d)
+		if best == "" || d < bestTime {
+			best, bestTime = b, d
+		}
+	}
+	fmt.Fprintf(w, "  → %s\n", best)
+	return best, nil
+}
+
+// calibrateParallelMinSize 크기를 두 배씩 늘려 병렬 인트로소트가 순차보다 빨라지는 첫 크기를 찾음
+// 임계값 때문에 한 번도 나누지 않는 크기는 순차와 같으므로 건너뜁니다.
+// 끝까지 빨라지지 않으면 (코어가 하나뿐인 경우 등) 상한의 두 배를 반환해 병렬 처리를 끕니다.
+func calibrateParallelMinSize(w io.Writer, t sorts.Tuning, runs int) (int, error) {
+	for size := 256; size <= calibrationMaxMinSize; size *= 2 {
+		if size <= 2*t.Threshold(size) {
+			continue
+		}
+
+		data := generateRandomData(size)
+		seq, err := timeSorts(t, data, runs, sorts.IntroSort[int])
+		if err != nil {
+			return 0, err
+		}
+		par, err := timeSorts(t, data, runs, sorts.ParallelIntroSort[int])
+		if err != nil {
+			return 0, err
+		}
+
+		fmt.Fprintf(w, "  %8d개: 순차 %v, 병렬 %v\n", size, seq, par)
+		if par < seq {
+			return size, nil
+		}
+	}
+	return 2 * calibrationMaxMinSize, nil
+}
+
+// pickCandidate 후보마다 measure 를 실행해 가장 빠른 값을 고름
+func pickCandidate(w io.Writer, candidates []int, measure func(c int) (time.Duration, error)) (int, error) {
+	best, bestTime := 0, time.Duration(0)
+	for _, c := range candidates {
+		d, err := measure(c)
+		if err != nil {
+			return 0, err
+		}
+		fmt.Fprintf(w, "  %6d: %v\n", c, d)
+		if best == 0 || d < bestTime {
+			best, bestTime = c, d
+		}
+	}
+	fmt.Fprintf(w, "  → %d\n", best)
+	return best, nil
+}
+
+// timeSorts t 를 적용한 채 각 정렬을 (예열 한 번 뒤) runs 번 실행한 중앙값의 합
+// 매번 data 의 복사본을 정렬하며, 결과가 정렬되지 않았으면 오류를 반환합니다.
+func timeSorts(t sorts.Tuning, data []int, runs int, fns ...func([]int)) (time.Duration, error) {
+	if err := sorts.SetTuning(t); err != nil {
+		return 0, err
+	}
+
+	var total time.Duration
+	arr := make([]int, len(data))
+	samples := make([]time.Duration, runs)
+	for _, fn := range fns {
+		copy(arr, data)
+		fn(arr) // 예열
+
+		for r := range samples {
+			copy(arr, data)
+			start := time.Now()
+			fn(arr)
+			samples[r] = time.Since(start)
+
+			if !slices.IsSorted(arr) {
+				return 0, fmt.Errorf("보정 중 정렬 결과가 올바르지 않습니다 (%+v)", t)
+			}
+		}
+		slices.Sort(samples)
+		total += samples[runs/2]
+	}
+	return total, nil
+}
+
+// calibrate -calibrate 모드: 측정한 임계값을 path 에 프로파일로 저장
+func calibrate(w io.Writer, path string, runs int) error {
+	fmt.Fprintf(w, "임계값 보정 시작 (측정 %d회, 프로파일: %s)\n\n", runs, path)
+	t, err := runCalibration(w, max(1, runs))
+	if err != nil {
+		return err
+	}
+	if err := sorts.SaveTuningProfile(path, sorts.NewTuningProfile(t)); err != nil {
+		return fmt.Errorf("튜닝 프로파일 저장 오류: %w", err)
+	}
+
+	def := sorts.DefaultTuning()
+	fmt.Fprintf(w, "\n%-18s %8s %8s\n", "", "기본값", "보정값")
+	for _, row := range []struct {
+		name     string
+		def, got int
+	}{
+		{"insertion_cutoff", def.InsertionCutoff, t.InsertionCutoff},
+		{"parallel_min_size", def.ParallelMinSize, t.ParallelMinSize},
+		{"small_threshold", def.SmallThreshold, t.SmallThreshold},
+		{"medium_threshold", def.MediumThreshold, t.MediumThreshold},
+		{"large_threshold", def.LargeThreshold, t.LargeThreshold},
+	} {
+		fmt.Fprintf(w, "%-18s %8d %8d\n", row.name, row.def, row.got)
+	}
+	fmt.Fprintf(w, "%-18s %8s %8s\n", "base_case", def.BaseCase, t.BaseCase)
+	fmt.Fprintf(w, "\n%s 에 저장했습니다.\n", path)
+	return nil
+}
+
+// tuningDescription 사용 중인 임계값과 출처 (결과 헤더용)
+func tuningDescription() string {
+	t, source := sorts.CurrentTuning()
+	if source == "" {
+		source = "기본값"
+	}
+	return fmt.Sprintf("%s %d, 병렬 최소 %d, 임계값 %d/%d/%d (%s)",
+		displayName(baseCaseNames, string(t.BaseCase)), t.InsertionCutoff, t.ParallelMinSize, t.SmallThreshold, t.MediumThreshold, t.LargeThreshold, source)
+}
@@ -0,0 +1,361 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math"
+	"os"
+	"slices"
+	"text/tabwriter"
+	"time"
+)
+
+// compareConfig 결과 비교 설정
+type compareConfig struct {
+	Threshold float64 // 이보다 큰 중앙값 증가율(0.05 = 5%)이면서 유의하면 회귀로 판단
+	Alpha     float64 // 유의수준
+}
+
+// compareRow 두 결과 파일에서 같은 조건끼리 짝지은 행
+type compareRow struct {
+	key      summaryKey
+	old, new []float64 // 반복 측정 실행시간 (ns)
+}
+
+// comparison compareRow 의 비교 결과
+type comparison struct {
+	oldMedian, newMedian float64
+	oldSpread, newSpread float64 // 중앙값 대비 최대 편차 비율
+	delta                float64 // 중앙값 변화율
+	p                    float64 // Mann-Whitney U 양측 p 값
+	minP                 float64 // 표본 크기로 낼 수 있는 가장 작은 p 값
+	insufficient         bool    // minP 가 유의수준 이상이라 회귀를 판단할 수 없음
+	significant          bool
+	regression           bool
+}
+
+// loadResults 결과 JSON 읽기
+// 현재 형식({"results": [...]})과 예전의 배열 형식([...])을 모두 읽습니다.
+// 예전 형식에 없는 분포는 무작위로 간주합니다.
+func loadResults(path string) ([]BenchmarkResult, error) {
+	raw, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var results []BenchmarkResult
+	if trimmed := bytes.TrimSpace(raw); len(trimmed) > 0 && trimmed[0] == '[' {
+		err = json.Unmarshal(raw, &results)
+	} else {
+		var report benchmarkReport
+		err = json.Unmarshal(raw, &report)
+		results = report.Results
+	}
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+
+	for i := range results {
+		if results[i].Distribution == "" {
+			results[i].Distribution = distRandom
+		}
+	}
+	return results, nil
+}
+
+// compareResults 두 결과 집합을 조건별로 짝지음 (새 결과의 순서 유지)
+// 두 파일이 각각 하나의 GOMAXPROCS 값으로만 측정되었다면 GOMAXPROCS 는 무시하고 짝짓습니다.
+func compareResults(oldResults, newResults []BenchmarkResult) (rows []compareRow, onlyOld, onlyNew int) {
+	ignoreProcs := singleProcs(oldResults) && singleProcs(newResults)
+	keyFor := func(r BenchmarkResult) summaryKey {
+		k := keyOf(r)
+		if ignoreProcs {
+			k.procs = 0
+		}
+		return k
+	}
+
+	oldGroups := make(map[summaryKey][]float64)
+	for _, r := range oldResults {
+		k := keyFor(r)
+		oldGroups[k] = append(oldGroups[k], float64(r.Duration))
+	}
+
+	index := make(map[summaryKey]int)
+	for _, r := range newResults {
+		k := keyFor(r)
+		i, ok := index[k]
+		if !ok {
+			i = len(rows)
+			index[k] = i
+			rows = append(rows, compareRow{key: k})
+		}
+		rows[i].new = append(rows[i].new, float64(r.Duration))
+	}
+
+	matched := rows[:0]
+	for _, row := range rows {
+		if old, ok := oldGroups[row.key]; ok {
+			row.old = old
+			matched = append(matched, row)
+		} else {
+			onlyNew++
+		}
+	}
+	onlyOld = len(oldGroups) - len(matched)
+	return matched, onlyOld, onlyNew
+}
+
+func singleProcs(results []BenchmarkResult) bool {
+	for _, r := range results {
+		if r.GOMAXPROCS != results[0].GOMAXPROCS {
+			return false
+		}
+	}
+	return true
+}
+
+// compare 한 행의 중앙값 변화와 유의성 계산
+func (row compareRow) compare(cfg compareConfig) comparison {
+	var c comparison
+	c.oldMedian, c.oldSpread = medianSpread(row.old)
+	c.newMedian, c.newSpread = medianSpread(row.new)
+	if c.oldMedian > 0 {
+		c.delta = (c.newMedian - c.oldMedian) / c.oldMedian
+	}
+
+	c.p = mannWhitneyU(row.old, row.new)
+	c.minP = minMannWhitneyP(len(row.old), len(row.new))
+	c.insufficient = c.minP >= cfg.Alpha
+	c.significant = c.p < cfg.Alpha
+	c.regression = c.significant && c.delta > cfg.Threshold
+	return c
+}
+
+// medianSpread 중앙값과 중앙값 대비 최대 편차 비율
+func medianSpread(samples []float64) (float64, float64) {
+	sorted := slices.Clone(samples)
+	slices.Sort(sorted)
+	median := percentile(sorted, 0.5)
+	if median == 0 {
+		return 0, 0
+	}
+	spread := max(median-sorted[0], sorted[len(sorted)-1]-median)
+	return median, spread / median
+}
+
+// runCompare 두 결과 파일을 비교해 benchstat 형식의 표를 출력
+// 회귀가 하나라도 있으면 true 를 반환합니다.
+// 회귀는 없지만 측정 횟수가 적어 유의성을 판단할 수 없는 조건이 있으면 오류를 반환합니다.
+func runCompare(w io.Writer, oldPath, newPath string, cfg compareConfig) (bool, error) {
+	oldResults, err := loadResults(oldPath)
+	if err != nil {
+		return false, err
+	}
+	newResults, err := loadResults(newPath)
+	if err != nil {
+		return false, err
+	}
+
+	rows, onlyOld, onlyNew := compareResults(oldResults, newResults)
+	if len(rows) == 0 {
+		return false, fmt.Errorf("두 결과 파일에 공통 조건이 없습니다")
+	}
+
+	fmt.Fprintf(w, "이전: %s\n새 결과: %s\n\n", oldPath, newPath)
+	tw := tabwriter.NewWriter(w, 0, 4, 2, ' ', 0)
+	fmt.Fprintf(tw, "조건\t이전\t\t새 결과\t\t변화\t\t\n")
+
+	var regressions, insufficient []string
+	for _, row := range rows {
+		c := row.compare(cfg)
+		name := fmt.Sprintf("%s/%d/%s/%s", row.key.algorithm, row.key.size, row.key.storage, row.key.distribution)
+		if row.key.k != 0 {
+			name += fmt.Sprintf("/k=%d", row.key.k)
+		}
+		if row.key.keyType != "" {
+			name += "/key=" + row.key.keyType
+		}
+		if row.key.records {
+			name += "/records"
+		}
+		if row.key.procs != 0 {
+			name += fmt.Sprintf("/procs=%d", row.key.procs)
+		}
+
+		delta := "~"
+		if c.significant {
+			delta = fmt.Sprintf("%+.2f%%", c.delta*100)
+		}
+		mark := ""
+		switch {
+		case c.regression:
+			mark = "회귀"
+			regressions = append(regressions, name)
+		case c.insufficient:
+			mark = fmt.Sprintf("측정 부족 (최소 p=%.3f)", c.minP)
+			insufficient = append(insufficient, name)
+		}
+
+		fmt.Fprintf(tw, "%s\t%v\t±%.0f%%\t%v\t±%.0f%%\t%s\t(p=%.3f n=%d+%d)\t%s\n",
+			name,
+			time.Duration(c.oldMedian).Round(time.Microsecond/10), c.oldSpread*100,
+			time.Duration(c.newMedian).Round(time.Microsecond/10), c.newSpread*100,
+			delta, c.p, len(row.old), len(row.new), mark)
+	}
+	if err := tw.Flush(); err != nil {
+		return false, err
+	}
+
+	if onlyOld > 0 || onlyNew > 0 {
+		fmt.Fprintf(w, "\n한쪽에만 있는 조건: 이전 %d개, 새 결과 %d개 (비교에서 제외)\n", onlyOld, onlyNew)
+	}
+	if len(regressions) > 0 {
+		fmt.Fprintf(w, "\n❌ 회귀 %d건 (중앙값 %.1f%% 초과 증가, p < %.3f)\n", len(regressions), cfg.Threshold*100, cfg.Alpha)
+		for _, name := range regressions {
+			fmt.Fprintf(w, "  %s\n", name)
+		}
+		return true, nil
+	}
+	if len(insufficient) > 0 {
+		fmt.Fprintln(w)
+		return false, fmt.Errorf("측정 횟수가 부족해 %d개 조건의 회귀를 판단할 수 없습니다 (p < %.3f 를 내려면 양쪽 모두 %d회 이상 측정 필요)",
+			len(insufficient), cfg.Alpha, minRunsForAlpha(cfg.Alpha))
+	}
+	fmt.Fprintf(w, "\n✅ 회귀 없음 (임계값 %.1f%%, p < %.3f)\n", cfg.Threshold*100, cfg.Alpha)
+	return false, nil
+}
+
+// ====================================================================================
+// Mann-Whitney U 검정
+// ====================================================================================
+
+// maxExactU 두 표본이 모두 이 크기 이하이고 동률이 없으면 정확한 분포로 p 값을 계산
+const maxExactU = 50
+
+// mannWhitneyU 두 독립 표본의 분포 위치가 같다는 귀무가설에 대한 양측 p 값
+// 표본이 작고 동률이 없으면 U 의 정확한 분포를, 그 외에는 동률 보정한 정규 근사를 사용합니다.
+func mannWhitneyU(x, y []float64) float64 {
+	n1, n2 := len(x), len(y)
+	if n1 == 0 || n2 == 0 {
+		return 1
+	}
+
+	type sample struct {
+		v     float64
+		fromX bool
+	}
+	all := make([]sample, 0, n1+n2)
+	for _, v := range x {
+		all = append(all, sample{v, true})
+	}
+	for _, v := range y {
+		all = append(all, sample{v, false})
+	}
+	slices.SortFunc(all, func(a, b sample) int {
+		switch {
+		case a.v < b.v:
+			return -1
+		case a.v > b.v:
+			return 1
+		}
+		return 0
+	})
+
+	// 동률은 평균 순위, 동률 보정항 Σ(t³ - t) 누적
+	var rankSumX, tieTerm float64
+	ties := false
+	for i := 0; i < len(all); {
+		j := i
+		for j < len(all) && all[j].v == all[i].v {
+			j++
+		}
+		rank := float64(i+j+1) / 2 // 순위 i+1 ~ j 의 평균
+		for k := i; k < j; k++ {
+			if all[k].fromX {
+				rankSumX += rank
+			}
+		}
+		if t := float64(j - i); t > 1 {
+			ties = true
+			tieTerm += t*t*t - t
+		}
+		i = j
+	}
+
+	u1 := rankSumX - float64(n1*(n1+1))/2
+	u := min(u1, float64(n1*n2)-u1)
+
+	if !ties && n1 <= maxExactU && n2 <= maxExactU {
+		return min(1, 2*exactUCDF(n1, n2, int(u)))
+	}
+
+	n := float64(n1 + n2)
+	mu := float64(n1*n2) / 2
+	variance := float64(n1*n2) / 12 * ((n + 1) - tieTerm/(n*(n-1)))
+	if variance <= 0 {
+		return 1
+	}
+	z := (u - mu + 0.5) / math.Sqrt(variance) // 연속성 보정
+	return min(1, math.Erfc(-z/math.Sqrt2))
+}
+
+// minMannWhitneyP 크기 n1, n2 표본에서 나올 수 있는 가장 작은 양측 p 값 2/C(n1+n2, n1)
+// 두 표본이 완전히 나뉘었을 때(U = 0)의 값이며, 이것이 유의수준 이상이면 어떤 측정으로도 유의할 수 없습니다.
+func minMannWhitneyP(n1, n2 int) float64 {
+	if n1 == 0 || n2 == 0 {
+		return 1
+	}
+	// C(n1+n2, n1) = Π (n2+i)/i, i = 1..n1
+	c := 1.0
+	for i := 1; i <= n1; i++ {
+		c = c * float64(n2+i) / float64(i)
+	}
+	return min(1, 2/c)
+}
+
+// minRunsForAlpha 양쪽 측정 횟수가 같을 때 p < alpha 가 가능한 최소 측정 횟수 (maxExactU 까지)
+func minRunsForAlpha(alpha float64) int {
+	n := 1
+	for n < maxExactU && minMannWhitneyP(n, n) >= alpha {
+		n++
+	}
+	return n
+}
+
+// exactUCDF 동률이 없을 때 P(U ≤ u)
+// 크기 m, n 표본에서 U = u 가 되는 배치 수는 c(m,n,u) = c(m-1,n,u-n) + c(m,n-1,u) 를 만족합니다.
+func exactUCDF(n1, n2, u int) float64 {
+	// counts[j] = 크기 (i, j) 에서의 U 빈도 분포 (i 를 0..n1 으로 늘려가며 갱신)
+	counts := make([][]float64, n2+1)
+	for j := range counts {
+		counts[j] = []float64{1} // i = 0 이면 U 는 항상 0
+	}
+	for i := 1; i <= n1; i++ {
+		next := make([][]float64, n2+1)
+		next[0] = []float64{1}
+		for j := 1; j <= n2; j++ {
+			dist := make([]float64, i*j+1)
+			for v, c := range counts[j] { // c(i-1, j, v) → U = v + j
+				dist[v+j] += c
+			}
+			for v, c := range next[j-1] { // c(i, j-1, v)
+				dist[v] += c
+			}
+			next[j] = dist
+		}
+		counts = next
+	}
+
+	dist := counts[n2]
+	var below, total float64
+	for v, c := range dist {
+		total += c
+		if v <= u {
+			below += c
+		}
+	}
+	return below / total
+}
@@ -0,0 +1,90 @@
+package main
+
+import (
+	"math"
+	"testing"
+)
+
+// 양측 유의수준 0.05 의 Mann-Whitney U 임계값 표 (U ≤ 임계값이면 유의)
+// 정확한 분포에서 P(U ≤ 임계값) ≤ 0.025 < P(U ≤ 임계값 + 1) 이어야 합니다.
+func TestExactUCDFCriticalValues(t *testing.T) {
+	for _, tc := range []struct{ n1, n2, critical int }{
+		{4, 4, 0},
+		{5, 5, 2},
+		{6, 6, 5},
+		{8, 8, 13},
+		{10, 10, 23},
+		{5, 10, 8},
+		{20, 20, 127},
+	} {
+		at, above := exactUCDF(tc.n1, tc.n2, tc.critical), exactUCDF(tc.n1, tc.n2, tc.critical+1)
+		if at > 0.025 || above <= 0.025 {
+			t.Errorf("n=%d,%d: P(U≤%d)=%.4f, P(U≤%d)=%.4f (임계값 %d 와 맞지 않음)",
+				tc.n1, tc.n2, tc.critical, at, tc.critical+1, above, tc.critical)
+		}
+	}
+}
+
+func TestExactUCDFKnownValues(t *testing.T) {
+	for _, tc := range []struct {
+		n1, n2, u int
+		want      float64
+	}{
+		{3, 3, 0, 1.0 / 20},
+		{3, 3, 1, 2.0 / 20},
+		{3, 3, 4, 10.0 / 20}, // U=0..9 빈도 1,1,2,3,3,3,3,2,1,1
+		{4, 4, 0, 1.0 / 70},
+		{5, 5, 2, 4.0 / 252},
+		{3, 5, 15, 1},
+	} {
+		if got := exactUCDF(tc.n1, tc.n2, tc.u); math.Abs(got-tc.want) > 1e-12 {
+			t.Errorf("exactUCDF(%d, %d, %d) = %v, 기대 %v", tc.n1, tc.n2, tc.u, got, tc.want)
+		}
+	}
+}
+
+func TestMannWhitneyU(t *testing.T) {
+	for _, tc := range []struct {
+		name string
+		x, y []float64
+		want float64
+	}{
+		{"3회 완전 분리", []float64{1, 2, 3}, []float64{4, 5, 6}, 0.1},
+		{"5회 완전 분리", []float64{1, 2, 3, 4, 5}, []float64{6, 7, 8, 9, 10}, 2.0 / 252},
+		{"순서 무관", []float64{10, 9, 8, 7, 6}, []float64{5, 4, 3, 2, 1}, 2.0 / 252},
+		{"교차", []float64{1, 4, 5}, []float64{2, 3, 6}, 1},
+		{"모두 같음", []float64{5, 5, 5}, []float64{5, 5, 5}, 1},
+		{"빈 표본", nil, []float64{1}, 1},
+	} {
+		if got := mannWhitneyU(tc.x, tc.y); math.Abs(got-tc.want) > 1e-9 {
+			t.Errorf("%s: p = %v, 기대 %v", tc.name, got, tc.want)
+		}
+	}
+
+	// 동률이 있으면 정규 근사: 크게 분리된 표본은 유의해야 함
+	x := []float64{1, 1, 2, 2, 3, 3, 4, 4}
+	y := []float64{10, 10, 11, 11, 12, 12, 13, 13}
+	if p := mannWhitneyU(x, y); p >= 0.01 {
+		t.Errorf("동률 정규 근사: p = %v, 0.01 미만 기대", p)
+	}
+}
+
+func TestMinMannWhitneyP(t *testing.T) {
+	for _, tc := range []struct {
+		n1, n2 int
+		want   float64
+	}{
+		{3, 3, 0.1},
+		{4, 4, 2.0 / 70},
+		{5, 5, 2.0 / 252},
+		{1, 1, 1},
+		{0, 5, 1},
+	} {
+		if got := minMannWhitneyP(tc.n1, tc.n2); math.Abs(got-tc.want) > 1e-12 {
+			t.Errorf("minMannWhitneyP(%d, %d) = %v, 기대 %v", tc.n1, tc.n2, got, tc.want)
+		}
+	}
+	if n := minRunsForAlpha(0.05); n != 4 {
+		t.Errorf("alpha 0.05 에 필요한 측정 횟수 %d, 기대 4", n)
+	}
+}
@@ -0,0 +1,10 @@
+//go:build !unix
+
+package main
+
+import "time"
+
+// processCPUTime getrusage 를 지원하지 않는 플랫폼에서는 측정하지 않음
+func processCPUTime() (user, system time.Duration, ok bool) {
+	return 0, 0, false
+}
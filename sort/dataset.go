@@ -0,0 +1,275 @@
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math"
+	"os"
+	"runtime"
+	"strconv"
+	"sync"
+)
+
+// 파일 모드 입력 형식
+//  - file   : 한 줄에 정수 하나인 텍스트 (외부 정렬 입력과 같은 형식)
+//  - binary : 헤더 + 리틀 엔디언 정수 배열, 메모리 매핑으로 읽음
+//
+// 바이너리 헤더 (16 바이트, 리틀 엔디언)
+//  0  magic   "SRTD"
+//  4  version uint16 (binaryVersion)
+//  6  width   uint16 (원소 바이트 수, 4 또는 8 - 부호 있는 정수)
+//  8  count   uint64 (원소 수)
+
+// 바이너리 형식 설정
+const (
+	binaryMagic      = "SRTD"
+	binaryVersion    = 1
+	binaryHeaderSize = 16
+)
+
+// parseChunkMin 텍스트 병렬 파싱에서 청크당 최소 바이트 수
+const parseChunkMin = 64 * 1024
+
+// datasetFilename 저장방식별 입력 파일 이름
+func datasetFilename(storage, distribution string, size int) string {
+	if storage == "binary" {
+		return fmt.Sprintf("test_data_%s_%d.bin", distribution, size)
+	}
+	return fmt.Sprintf("test_data_%s_%d.txt", distribution, size)
+}
+
+// writeDataset 저장방식에 맞는 형식으로 입력 파일 쓰기
+func writeDataset(storage string, data []int, filename string) error {
+	if storage == "binary" {
+		return writeBinaryDataset(data, filename)
+	}
+	return writeDataToFile(data, filename)
+}
+
+// loadDataset 저장방식에 맞는 형식으로 입력 파일 읽기
+func loadDataset(storage, filename string) ([]int, error) {
+	if storage == "binary" {
+		return readBinaryDataset(filename)
+	}
+	return readDataFromFile(filename)
+}
+
+// writeBinaryDataset 바이너리 형식으로 쓰기
+// 모든 값이 int32 범위면 원소당 4 바이트, 아니면 8 바이트를 씁니다.
+func writeBinaryDataset(data []int, filename string) error {
+	width := 4
+	for _, v := range data {
+		if v < math.MinInt32 || v > math.MaxInt32 {
+			width = 8
+			break
+		}
+	}
+
+	file, err := os.Create(filename)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	writer := bufio.NewWriterSize(file, 64*1024)
+
+	var header [binaryHeaderSize]byte
+	copy(header[:4], binaryMagic)
+	binary.LittleEndian.PutUint16(header[4:], binaryVersion)
+	binary.LittleEndian.PutUint16(header[6:], uint16(width))
+	binary.LittleEndian.PutUint64(header[8:], uint64(len(data)))
+	writer.Write(header[:])
+
+	var elem [8]byte
+	for _, v := range data {
+		if width == 4 {
+			binary.LittleEndian.PutUint32(elem[:], uint32(int32(v)))
+		} else {
+			binary.LittleEndian.PutUint64(elem[:], uint64(v))
+		}
+		writer.Write(elem[:width])
+	}
+
+	if err := writer.Flush(); err != nil {
+		return err
+	}
+	return file.Close()
+}
+
+// readBinaryDataset 바이너리 형식 파일을 메모리 매핑해 병렬로 디코딩
+func readBinaryDataset(filename string) ([]int, error) {
+	buf, unmap, err := mapFile(filename)
+	if err != nil {
+		return nil, err
+	}
+	defer unmap()
+
+	width, count, err := parseBinaryHeader(buf)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", filename, err)
+	}
+	payload := buf[binaryHeaderSize:]
+
+	data := make([]int, count)
+	parallelChunks(count, decodeChunkCount(count), func(_, lo, hi int) {
+		src := payload[lo*width : hi*width]
+		dst := data[lo:hi]
+		if width == 4 {
+			for i := range dst {
+				dst[i] = int(int32(binary.LittleEndian.Uint32(src[i*4:])))
+			}
+		} else {
+			for i := range dst {
+				dst[i] = int(int64(binary.LittleEndian.Uint64(src[i*8:])))
+			}
+		}
+	})
+	return data, nil
+}
+
+// parseBinaryHeader 헤더 검사 후 원소 폭과 개수 반환
+func parseBinaryHeader(buf []byte) (width, count int, err error) {
+	if len(buf) < binaryHeaderSize || string(buf[:4]) != binaryMagic {
+		return 0, 0, errors.New("바이너리 데이터셋 형식이 아닙니다")
+	}
+	if v := binary.LittleEndian.Uint16(buf[4:]); v != binaryVersion {
+		return 0, 0, fmt.Errorf("지원하지 않는 바이너리 데이터셋 버전: %d", v)
+	}
+
+	width = int(binary.LittleEndian.Uint16(buf[6:]))
+	if width != 4 && width != 8 {
+		return 0, 0, fmt.Errorf("지원하지 않는 원소 폭: %d", width)
+	}
+	n := binary.LittleEndian.Uint64(buf[8:])
+	if payload := uint64(len(buf) - binaryHeaderSize); n > payload/uint64(width) || n*uint64(width) != payload {
+		return 0, 0, fmt.Errorf("원소 수(%d)와 파일 크기(%d 바이트)가 맞지 않습니다", n, len(buf))
+	}
+	return width, int(n), nil
+}
+
+// readDataFromFile 텍스트 형식 파일을 청크 단위로 병렬 파싱
+// 파일을 GOMAXPROCS 개 안팎의 청크로 나누되 경계는 다음 줄 시작으로 옮겨
+// 각 청크가 완전한 줄만 파싱하게 한 뒤, 청크별 결과를 순서대로 이어 붙입니다.
+func readDataFromFile(filename string) ([]int, error) {
+	buf, unmap, err := mapFile(filename)
+	if err != nil {
+		return nil, err
+	}
+	defer unmap()
+
+	chunks := max(1, min(runtime.GOMAXPROCS(0), len(buf)/parseChunkMin))
+	bounds := lineChunkBounds(buf, chunks)
+
+	parts := make([][]int, chunks)
+	errs := make([]error, chunks)
+	parallelChunks(chunks, chunks, func(c, _, _ int) {
+		parts[c], errs[c] = parseLines(buf[bounds[c]:bounds[c+1]])
+	})
+	if err := errors.Join(errs...); err != nil {
+		return nil, err
+	}
+
+	// 청크별 결과를 이어 붙임 (복사도 청크마다 병렬)
+	offsets := make([]int, chunks+1)
+	for c, part := range parts {
+		offsets[c+1] = offsets[c] + len(part)
+	}
+	data := make([]int, offsets[chunks])
+	parallelChunks(chunks, chunks, func(c, _, _ int) {
+		copy(data[offsets[c]:], parts[c])
+	})
+	return data, nil
+}
+
+// lineChunkBounds buf 를 대략 같은 크기의 chunks 개 구간으로 나눈 경계 (각 경계는 줄 시작)
+func lineChunkBounds(buf []byte, chunks int) []int {
+	bounds := make([]int, chunks+1)
+	for c := 1; c < chunks; c++ {
+		pos := max(bounds[c-1], len(buf)*c/chunks)
+		if i := bytes.IndexByte(buf[pos:], '\n'); i >= 0 {
+			pos += i + 1
+		} else {
+			pos = len(buf)
+		}
+		bounds[c] = pos
+	}
+	bounds[chunks] = len(buf)
+	return bounds
+}
+
+// parseLines 줄마다 정수 하나를 파싱 (앞뒤 공백과 빈 줄은 무시)
+func parseLines(buf []byte) ([]int, error) {
+	data := make([]int, 0, len(buf)/7) // 대략적인 숫자 개수 추정 (평균 6자리 + 개행)
+	for len(buf) > 0 {
+		line := buf
+		if i := bytes.IndexByte(buf, '\n'); i >= 0 {
+			line, buf = buf[:i], buf[i+1:]
+		} else {
+			buf = nil
+		}
+
+		line = bytes.TrimSpace(line)
+		if len(line) == 0 {
+			continue
+		}
+		num, ok := parseDecimal(line)
+		if !ok {
+			// 드문 형식 ("+12", 범위 초과 등)은 strconv 가 처리하고 오류 메시지도 만듦
+			var err error
+			if num, err = strconv.Atoi(string(line)); err != nil {
+				return nil, err
+			}
+		}
+		data = append(data, num)
+	}
+	return data, nil
+}
+
+// parseDecimal 부호(-)와 18 자리 이하 십진수만 빠르게 파싱 (넘치지 않음)
+func parseDecimal(s []byte) (int, bool) {
+	neg := s[0] == '-'
+	if neg {
+		s = s[1:]
+	}
+	if len(s) == 0 || len(s) > 18 {
+		return 0, false
+	}
+
+	n := 0
+	for _, c := range s {
+		if c < '0' || c > '9' {
+			return 0, false
+		}
+		n = n*10 + int(c-'0')
+	}
+	if neg {
+		n = -n
+	}
+	return n, true
+}
+
+// decodeChunkCount 바이너리 디코딩 청크 수
+func decodeChunkCount(count int) int {
+	return max(1, min(runtime.GOMAXPROCS(0), count/(parseChunkMin/8)))
+}
+
+// parallelChunks [0, n) 을 chunks 개 구간으로 나눠 고루틴마다 fn(c, lo, hi) 실행
+func parallelChunks(n, chunks int, fn func(c, lo, hi int)) {
+	if chunks <= 1 {
+		fn(0, 0, n)
+		return
+	}
+
+	var wg sync.WaitGroup
+	for c := range chunks {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			fn(c, n*c/chunks, n*(c+1)/chunks)
+		}()
+	}
+	wg.Wait()
+}
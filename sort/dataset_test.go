@@ -0,0 +1,179 @@
+package main
+
+import (
+	"encoding/binary"
+	"fmt"
+	"math"
+	"os"
+	"path/filepath"
+	"runtime"
+	"slices"
+	"strings"
+	"testing"
+)
+
+// withProcs 테스트 동안 GOMAXPROCS 를 procs 로 바꿔 단일 코어에서도 병렬 청크 경로를 실행
+func withProcs(t *testing.T, procs int) {
+	old := runtime.GOMAXPROCS(procs)
+	t.Cleanup(func() { runtime.GOMAXPROCS(old) })
+}
+
+func TestBinaryDatasetRoundTrip(t *testing.T) {
+	withProcs(t, 4)
+
+	large := make([]int, 100000) // decodeChunkCount 가 여러 청크로 나누는 크기
+	for i := range large {
+		large[i] = (i*7919)%100003 - 50000
+	}
+	for _, tc := range []struct {
+		name  string
+		data  []int
+		width int
+	}{
+		{"빈 입력", nil, 4},
+		{"int32 범위", []int{0, 1, -1, math.MaxInt32, math.MinInt32}, 4},
+		{"int32 범위 밖", []int{-5, 1 << 40, math.MaxInt64, math.MinInt64}, 8},
+		{"한 값만 넘침", []int{1, 2, math.MaxInt32 + 1}, 8},
+		{"여러 청크", large, 4},
+	} {
+		filename := filepath.Join(t.TempDir(), "data.bin")
+		if err := writeBinaryDataset(tc.data, filename); err != nil {
+			t.Fatalf("%s: 쓰기 오류: %v", tc.name, err)
+		}
+
+		raw, err := os.ReadFile(filename)
+		if err != nil {
+			t.Fatal(err)
+		}
+		width, count, err := parseBinaryHeader(raw)
+		if err != nil || width != tc.width || count != len(tc.data) {
+			t.Fatalf("%s: 헤더 폭 %d 개수 %d (%v), 기대 폭 %d 개수 %d", tc.name, width, count, err, tc.width, len(tc.data))
+		}
+
+		got, err := readBinaryDataset(filename)
+		if err != nil {
+			t.Fatalf("%s: 읽기 오류: %v", tc.name, err)
+		}
+		if !slices.Equal(got, tc.data) {
+			t.Fatalf("%s: 읽은 값이 쓴 값과 다름", tc.name)
+		}
+	}
+}
+
+// binaryHeader 테스트용 바이너리 파일 내용 (헤더 + 0 으로 채운 payload 바이트)
+func binaryHeader(magic string, version, width uint16, count uint64, payload int) []byte {
+	buf := make([]byte, binaryHeaderSize+payload)
+	copy(buf, magic)
+	binary.LittleEndian.PutUint16(buf[4:], version)
+	binary.LittleEndian.PutUint16(buf[6:], width)
+	binary.LittleEndian.PutUint64(buf[8:], count)
+	return buf
+}
+
+func TestParseBinaryHeaderErrors(t *testing.T) {
+	for _, tc := range []struct {
+		name string
+		buf  []byte
+		want string
+	}{
+		{"헤더보다 짧음", []byte(binaryMagic), "형식이 아닙니다"},
+		{"잘못된 magic", binaryHeader("SRTX", binaryVersion, 4, 0, 0), "형식이 아닙니다"},
+		{"잘못된 버전", binaryHeader(binaryMagic, binaryVersion+1, 4, 0, 0), "버전"},
+		{"잘못된 폭", binaryHeader(binaryMagic, binaryVersion, 3, 0, 0), "원소 폭"},
+		{"잘린 페이로드", binaryHeader(binaryMagic, binaryVersion, 8, 3, 16), "맞지 않습니다"},
+		{"남는 페이로드", binaryHeader(binaryMagic, binaryVersion, 4, 1, 8), "맞지 않습니다"},
+		{"개수 넘침", binaryHeader(binaryMagic, binaryVersion, 8, math.MaxUint64/4, 0), "맞지 않습니다"},
+	} {
+		_, _, err := parseBinaryHeader(tc.buf)
+		if err == nil || !strings.Contains(err.Error(), tc.want) {
+			t.Errorf("%s: 오류 %v, 기대 %q 포함", tc.name, err, tc.want)
+		}
+	}
+
+	if width, count, err := parseBinaryHeader(binaryHeader(binaryMagic, binaryVersion, 4, 2, 8)); err != nil || width != 4 || count != 2 {
+		t.Errorf("정상 헤더: 폭 %d 개수 %d 오류 %v", width, count, err)
+	}
+}
+
+func TestParseTextChunks(t *testing.T) {
+	// 빈 줄, 공백, "+12", CRLF, 마지막 개행 없음
+	text := "5\n\n  -3 \n+12\n0\r\n\n\n-9223372036854775808\n42\n7"
+	want := []int{5, -3, 12, 0, math.MinInt64, 42, 7}
+
+	buf := []byte(text)
+	seq, err := parseLines(buf)
+	if err != nil || !slices.Equal(seq, want) {
+		t.Fatalf("parseLines = %v (%v), 기대 %v", seq, err, want)
+	}
+
+	// 청크 경계가 줄 중간, 빈 줄, 파일 끝에 떨어지는 경우 모두 순차 파싱과 같아야 함
+	for chunks := 1; chunks <= len(buf)+1; chunks++ {
+		bounds := lineChunkBounds(buf, chunks)
+		var got []int
+		for c := range chunks {
+			if bounds[c] > bounds[c+1] {
+				t.Fatalf("chunks=%d: 경계가 거꾸로 됨 %v", chunks, bounds)
+			}
+			if bounds[c] > 0 && bounds[c] < len(buf) && buf[bounds[c]-1] != '\n' {
+				t.Fatalf("chunks=%d: 경계 %d 가 줄 시작이 아님", chunks, bounds[c])
+			}
+			part, err := parseLines(buf[bounds[c]:bounds[c+1]])
+			if err != nil {
+				t.Fatalf("chunks=%d: %v", chunks, err)
+			}
+			got = append(got, part...)
+		}
+		if !slices.Equal(got, want) {
+			t.Fatalf("chunks=%d: %v, 기대 %v", chunks, got, want)
+		}
+	}
+
+	if _, err := parseLines([]byte("1\n12x\n")); err == nil {
+		t.Error("숫자가 아닌 줄에서 오류가 나야 함")
+	}
+}
+
+func TestReadDataFromFile(t *testing.T) {
+	withProcs(t, 4)
+
+	// 여러 청크로 나뉘도록 parseChunkMin 의 몇 배 크기 (빈 줄과 "+" 부호 포함, 마지막 개행 없음)
+	var sb strings.Builder
+	var want []int
+	for i := 0; sb.Len() < 5*parseChunkMin; i++ {
+		v := (i*7919)%200003 - 100000
+		switch i % 97 {
+		case 0:
+			sb.WriteString("\n")
+		case 1:
+			if v >= 0 {
+				sb.WriteString("+")
+			}
+		}
+		want = append(want, v)
+		fmt.Fprintf(&sb, "%d\n", v)
+	}
+	text := strings.TrimSuffix(sb.String(), "\n")
+
+	dir := t.TempDir()
+	for _, tc := range []struct {
+		name string
+		text string
+		want []int
+	}{
+		{"여러 청크", text, want},
+		{"빈 파일", "", nil},
+		{"한 줄", "17", []int{17}},
+	} {
+		filename := filepath.Join(dir, "data.txt")
+		if err := os.WriteFile(filename, []byte(tc.text), 0o644); err != nil {
+			t.Fatal(err)
+		}
+		got, err := readDataFromFile(filename)
+		if err != nil {
+			t.Fatalf("%s: %v", tc.name, err)
+		}
+		if !slices.Equal(got, tc.want) {
+			t.Fatalf("%s: %d개 읽음, 기대 %d개", tc.name, len(got), len(tc.want))
+		}
+	}
+}
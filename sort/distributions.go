@@ -0,0 +1,199 @@
+package main
+
+import (
+	"fmt"
+	"math"
+	"math/rand"
+	"slices"
+	"strings"
+)
+
+// 입력 분포 이름 (BenchmarkResult.Distribution 값)
+const (
+	distRandom       = "random"        // 균등 분포 [0, 1,000,000)
+	distSorted       = "sorted"        // 이미 정렬됨
+	distReversed     = "reversed"      // 역순 정렬
+	distNearlySorted = "nearly_sorted" // 정렬 후 k번 무작위 교환
+	distFewUnique    = "few_unique"    // 고유값이 몇 개뿐
+	distOrganPipe    = "organ_pipe"    // 오름차순 후 내림차순
+	distSawtooth     = "sawtooth"      // 짧은 오름차순 구간 반복
+	distZipf         = "zipf"          // 소수의 값에 집중된 분포
+	distGaussian     = "gaussian"      // 정규 분포
+	distAdversarial  = "adversarial"   // median-of-3 킬러
+)
+
+// 분포 생성 설정
+const (
+	valueRange      = 1000000 // generateRandomData 와 같은 값 범위
+	distSeed        = 42      // 재현 가능한 벤치마크를 위한 고정 시드
+	fewUniqueValues = 10      // few_unique 분포의 고유값 수
+	sawtoothPeriods = 16      // sawtooth 분포의 톱니 수
+	zipfExponent    = 1.1     // zipf 분포 지수 (s > 1)
+	maxAdversarial  = 20000   // 적대적 입력 생성은 O(n²) 이므로 크기 제한
+)
+
+// allDistributions 벤치마크가 기본으로 사용하는 분포 순서
+var allDistributions = []string{
+	distRandom, distSorted, distReversed, distNearlySorted, distFewUnique,
+	distOrganPipe, distSawtooth, distZipf, distGaussian, distAdversarial,
+}
+
+// distributionNames 마크다운 출력용 분포 이름
+var distributionNames = map[string]string{
+	distRandom:       "무작위",
+	distSorted:       "정렬됨",
+	distReversed:     "역순",
+	distNearlySorted: "거의 정렬됨",
+	distFewUnique:    "적은 고유값",
+	distOrganPipe:    "오르간 파이프",
+	distSawtooth:     "톱니",
+	distZipf:         "Zipf",
+	distGaussian:     "가우시안",
+	distAdversarial:  "적대적 (median-of-3 킬러)",
+}
+
+// parseDistributions 쉼표로 구분된 분포 목록 파싱 ("all" 이면 전체)
+// 같은 분포를 두 번 쓰면 같은 조건이 두 번 측정되므로 오류로 처리합니다.
+func parseDistributions(spec string) ([]string, error) {
+	if spec == "" || spec == "all" {
+		return allDistributions, nil
+	}
+
+	var dists []string
+	for _, name := range strings.Split(spec, ",") {
+		name = strings.TrimSpace(name)
+		if _, ok := distributionNames[name]; !ok {
+			return nil, fmt.Errorf("알 수 없는 분포: %q", name)
+		}
+		if slices.Contains(dists, name) {
+			return nil, fmt.Errorf("중복된 분포: %q", name)
+		}
+		dists = append(dists, name)
+	}
+	return dists, nil
+}
+
+// generateData 분포 이름에 맞는 입력 데이터 생성
+func generateData(distribution string, size int) ([]int, error) {
+	switch distribution {
+	case distRandom:
+		return generateRandomData(size), nil
+	case distSorted:
+		return generateSortedData(size), nil
+	case distReversed:
+		return generateReversedData(size), nil
+	case distNearlySorted:
+		return generateNearlySortedData(size, max(1, size/100)), nil
+	case distFewUnique:
+		return generateFewUniqueData(size, fewUniqueValues), nil
+	case distOrganPipe:
+		return generateOrganPipeData(size), nil
+	case distSawtooth:
+		return generateSawtoothData(size, sawtoothPeriods), nil
+	case distZipf:
+		return generateZipfData(size), nil
+	case distGaussian:
+		return generateGaussianData(size), nil
+	case distAdversarial:
+		if size > maxAdversarial {
+			return nil, fmt.Errorf("적대적 입력은 %d개 이하만 생성할 수 있습니다: %d", maxAdversarial, size)
+		}
+		return generateAdversarialData(size), nil
+	}
+	return nil, fmt.Errorf("알 수 없는 분포: %q", distribution)
+}
+
+// generateSortedData 오름차순 데이터 (값 범위는 무작위 분포와 동일)
+func generateSortedData(size int) []int {
+	data := make([]int, size)
+	for i := range size {
+		data[i] = int(int64(i) * valueRange / int64(max(size, 1)))
+	}
+	return data
+}
+
+// generateReversedData 내림차순 데이터
+func generateReversedData(size int) []int {
+	data := generateSortedData(size)
+	for i, j := 0, size-1; i < j; i, j = i+1, j-1 {
+		data[i], data[j] = data[j], data[i]
+	}
+	return data
+}
+
+// generateNearlySortedData 정렬된 데이터에서 무작위 위치 쌍을 swaps 번 교환
+func generateNearlySortedData(size, swaps int) []int {
+	data := generateSortedData(size)
+	if size < 2 {
+		return data
+	}
+
+	r := rand.New(rand.NewSource(distSeed))
+	for range swaps {
+		i, j := r.Intn(size), r.Intn(size)
+		data[i], data[j] = data[j], data[i]
+	}
+	return data
+}
+
+// generateFewUniqueData uniques 개의 값만 무작위로 반복 (3-way 파티셔닝 효과 확인용)
+func generateFewUniqueData(size, uniques int) []int {
+	r := rand.New(rand.NewSource(distSeed))
+	step := valueRange / uniques
+
+	data := make([]int, size)
+	for i := range size {
+		data[i] = r.Intn(uniques) * step
+	}
+	return data
+}
+
+// generateOrganPipeData 앞 절반은 오름차순, 뒤 절반은 내림차순
+func generateOrganPipeData(size int) []int {
+	data := make([]int, size)
+	half := (size + 1) / 2
+	for i := range size {
+		pos := i
+		if i >= half {
+			pos = size - 1 - i
+		}
+		data[i] = int(int64(pos) * valueRange / int64(max(half, 1)))
+	}
+	return data
+}
+
+// generateSawtoothData periods 개의 오름차순 구간이 이어진 데이터
+func generateSawtoothData(size, periods int) []int {
+	period := max(1, (size+periods-1)/periods)
+
+	data := make([]int, size)
+	for i := range size {
+		data[i] = int(int64(i%period) * valueRange / int64(period))
+	}
+	return data
+}
+
+// generateZipfData Zipf 분포 (작은 값이 매우 자주 등장)
+func generateZipfData(size int) []int {
+	r := rand.New(rand.NewSource(distSeed))
+	z := rand.NewZipf(r, zipfExponent, 1, valueRange-1)
+
+	data := make([]int, size)
+	for i := range size {
+		data[i] = int(z.Uint64())
+	}
+	return data
+}
+
+// generateGaussianData 평균이 값 범위 중앙인 정규 분포 (범위 밖은 잘라냄)
+func generateGaussianData(size int) []int {
+	r := rand.New(rand.NewSource(distSeed))
+	mean, stddev := valueRange/2.0, valueRange/8.0
+
+	data := make([]int, size)
+	for i := range size {
+		v := math.Round(r.NormFloat64()*stddev + mean)
+		data[i] = int(min(max(v, 0), valueRange-1))
+	}
+	return data
+}
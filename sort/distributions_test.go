@@ -0,0 +1,101 @@
+package main
+
+import (
+	"slices"
+	"strings"
+	"testing"
+)
+
+func TestParseDistributions(t *testing.T) {
+	for _, tc := range []struct {
+		spec string
+		want []string
+		err  string
+	}{
+		{"all", allDistributions, ""},
+		{"", allDistributions, ""},
+		{"random", []string{distRandom}, ""},
+		{" sorted , few_unique", []string{distSorted, distFewUnique}, ""},
+		{"random,uniform", nil, "알 수 없는 분포"},
+		{"random,all", nil, "알 수 없는 분포"}, // all 은 단독으로만
+		{"random,", nil, "알 수 없는 분포"},
+		{"sorted,random,sorted", nil, "중복된 분포"},
+		{"sorted, sorted", nil, "중복된 분포"},
+	} {
+		got, err := parseDistributions(tc.spec)
+		if tc.err != "" {
+			if err == nil || !strings.Contains(err.Error(), tc.err) {
+				t.Errorf("%q: 오류 %v, 기대 %q 포함", tc.spec, err, tc.err)
+			}
+			continue
+		}
+		if err != nil || !slices.Equal(got, tc.want) {
+			t.Errorf("%q: %v (%v), 기대 %v", tc.spec, got, err, tc.want)
+		}
+	}
+}
+
+func TestGenerateDataShapes(t *testing.T) {
+	const size = 10000
+	sorted := generateSortedData(size)
+
+	for _, dist := range allDistributions {
+		data, err := generateData(dist, size)
+		if err != nil {
+			t.Fatalf("%s: %v", dist, err)
+		}
+		if len(data) != size {
+			t.Fatalf("%s: 길이 %d, 기대 %d", dist, len(data), size)
+		}
+		for i, v := range data {
+			if v < 0 || v >= valueRange {
+				t.Fatalf("%s: data[%d] = %d 가 값 범위 밖", dist, i, v)
+			}
+		}
+
+		switch dist {
+		case distSorted:
+			if !slices.IsSorted(data) || data[0] == data[size-1] {
+				t.Errorf("sorted: 오름차순이 아니거나 값이 모두 같음")
+			}
+		case distReversed:
+			want := slices.Clone(sorted)
+			slices.Reverse(want)
+			if !slices.Equal(data, want) {
+				t.Errorf("reversed: sorted 의 역순이 아님")
+			}
+		case distFewUnique:
+			uniq := slices.Compact(slices.Sorted(slices.Values(data)))
+			if len(uniq) > fewUniqueValues || len(uniq) < 2 {
+				t.Errorf("few_unique: 고유값 %d개, 기대 2~%d개", len(uniq), fewUniqueValues)
+			}
+		case distNearlySorted:
+			// size/100 번 교환이므로 제자리를 벗어난 원소는 최대 2·size/100 개
+			moved := 0
+			for i := range data {
+				if data[i] != sorted[i] {
+					moved++
+				}
+			}
+			if moved == 0 || moved > 2*(size/100) {
+				t.Errorf("nearly_sorted: 제자리 아닌 원소 %d개, 기대 1~%d개", moved, 2*(size/100))
+			}
+			if !slices.Equal(slices.Sorted(slices.Values(data)), sorted) {
+				t.Errorf("nearly_sorted: sorted 와 원소가 다름")
+			}
+		}
+
+		// 같은 시드로 재현 가능
+		again, _ := generateData(dist, size)
+		if dist != distRandom && !slices.Equal(data, again) {
+			t.Errorf("%s: 두 번 생성한 데이터가 다름", dist)
+		}
+	}
+
+	if _, err := generateData(distAdversarial, maxAdversarial+1); err == nil {
+		t.Error("adversarial: 크기 제한을 넘으면 오류가 나야 함")
+	}
+	if _, err := generateData("uniform", size); err == nil {
+		t.Error("알 수 없는 분포에서 오류가 나야 함")
+	}
+}
@@ -0,0 +1,249 @@
+package main
+
+import (
+	"fmt"
+	"math"
+	"slices"
+	"time"
+)
+
+// harnessConfig 반복 측정 설정
+type harnessConfig struct {
+	Warmup  int           // 결과에 포함하지 않는 예열 실행 수
+	MinRuns int           // 최소 측정 횟수
+	MinTime time.Duration // 최소 누적 측정 시간 (MinRuns 를 채운 뒤에도 이 시간이 될 때까지 반복)
+	MaxRuns int           // 최대 측정 횟수 (MinTime 반복의 상한)
+	Pause   time.Duration // 실행 사이 시스템 안정화 대기 시간
+}
+
+// defaultHarnessConfig 기본 설정 (예열 1회 + 측정 5회, 실행 사이 50ms 대기)
+// 3회씩 측정한 두 결과는 Mann-Whitney U 검정의 p 가 0.1 아래로 내려갈 수 없어 -compare 가
+// 기본 유의수준(0.05)에서 회귀를 판단하지 못합니다. 5회면 최소 p 가 약 0.008 이 됩니다.
+var defaultHarnessConfig = harnessConfig{
+	Warmup:  1,
+	MinRuns: 5,
+	MaxRuns: 100,
+	Pause:   50 * time.Millisecond,
+}
+
+// repeatBenchmark 예열 후 설정한 횟수/시간만큼 측정을 반복
+// 측정한 결과에는 1부터 시작하는 TestRun 번호를 매기고, 검증에 실패하면 즉시 중단합니다.
+func repeatBenchmark(cfg harnessConfig, name string, measure func() (BenchmarkResult, error)) ([]BenchmarkResult, error) {
+	for i := 1; i <= cfg.Warmup; i++ {
+		fmt.Printf("  %s - 예열 %d\n", name, i)
+		result, err := measure()
+		if err != nil {
+			return nil, err
+		}
+		mustVerify(result)
+		time.Sleep(cfg.Pause)
+	}
+
+	var results []BenchmarkResult
+	var elapsed time.Duration
+
+	for run := 1; run <= cfg.MinRuns || (elapsed < cfg.MinTime && run <= cfg.MaxRuns); run++ {
+		fmt.Printf("  %s - 테스트 %d\n", name, run)
+		result, err := measure()
+		if err != nil {
+			return results, err
+		}
+		result.TestRun = run
+		mustVerify(result)
+
+		results = append(results, result)
+		elapsed += result.Duration
+		time.Sleep(cfg.Pause)
+	}
+	return results, nil
+}
+
+// ====================================================================================
+// 요약 통계
+// ====================================================================================
+
+// SummaryStats 같은 조건(알고리즘, GOMAXPROCS, 크기, 저장방식, 분포, 선택 k, 키 타입, 레코드 여부)의 반복 측정 요약
+// 이상치는 Tukey 울타리(Q1 - 1.5·IQR, Q3 + 1.5·IQR) 밖의 실행시간으로 판단해 제외합니다.
+type SummaryStats struct {
+	Algorithm    string `json:"algorithm"`
+	DataSize     int    `json:"data_size"`
+	StorageType  string `json:"storage_type"`
+	Distribution string `json:"distribution"`
+	SelectK      int    `json:"select_k,omitempty"`
+	KeyType      string `json:"key_type,omitempty"`
+	Records      bool   `json:"records,omitempty"`
+	GOMAXPROCS   int    `json:"gomaxprocs"`
+
+	Runs     int `json:"runs"`     // 전체 측정 횟수
+	Outliers int `json:"outliers"` // 이상치로 제외된 횟수
+
+	Mean   time.Duration `json:"mean"`
+	Median time.Duration `json:"median"`
+	P90    time.Duration `json:"p90"`
+	StdDev time.Duration `json:"stddev"`
+	CILow  time.Duration `json:"ci95_low"`  // 평균의 95% 신뢰구간 하한
+	CIHigh time.Duration `json:"ci95_high"` // 평균의 95% 신뢰구간 상한
+
+	MeanMemory uint64        `json:"mean_memory_bytes"`
+	MedianLoad time.Duration `json:"median_load,omitempty"` // 파일 모드의 입력 읽기 시간 중앙값
+}
+
+// summaryKey 결과 그룹 키
+type summaryKey struct {
+	algorithm    string
+	procs        int
+	size         int
+	storage      string
+	distribution string
+	k            int
+	keyType      string
+	records      bool
+}
+
+func keyOf(r BenchmarkResult) summaryKey {
+	return summaryKey{r.Algorithm, r.GOMAXPROCS, r.DataSize, r.StorageType, r.Distribution, r.SelectK, r.KeyType, r.Records}
+}
+
+func (s SummaryStats) key() summaryKey {
+	return summaryKey{s.Algorithm, s.GOMAXPROCS, s.DataSize, s.StorageType, s.Distribution, s.SelectK, s.KeyType, s.Records}
+}
+
+// computeSummaries 결과를 조건별로 묶어 요약 통계 계산 (처음 나타난 순서 유지)
+func computeSummaries(results []BenchmarkResult) []SummaryStats {
+	var order []summaryKey
+	groups := make(map[summaryKey][]BenchmarkResult)
+	for _, r := range results {
+		k := keyOf(r)
+		if _, ok := groups[k]; !ok {
+			order = append(order, k)
+		}
+		groups[k] = append(groups[k], r)
+	}
+
+	summaries := make([]SummaryStats, 0, len(order))
+	for _, k := range order {
+		summaries = append(summaries, summarize(groups[k]))
+	}
+	return summaries
+}
+
+// summarize 한 그룹의 요약 통계
+func summarize(group []BenchmarkResult) SummaryStats {
+	first := group[0]
+	s := SummaryStats{
+		Algorithm:    first.Algorithm,
+		DataSize:     first.DataSize,
+		StorageType:  first.StorageType,
+		Distribution: first.Distribution,
+		SelectK:      first.SelectK,
+		KeyType:      first.KeyType,
+		Records:      first.Records,
+		GOMAXPROCS:   first.GOMAXPROCS,
+		Runs:         len(group),
+	}
+
+	var totalMemory uint64
+	samples := make([]float64, 0, len(group))
+	loads := make([]float64, 0, len(group))
+	for _, r := range group {
+		samples = append(samples, float64(r.Duration))
+		loads = append(loads, float64(r.LoadDuration))
+		totalMemory += r.MemoryUsage
+	}
+	s.MeanMemory = totalMemory / uint64(len(group))
+	slices.Sort(loads)
+	s.MedianLoad = time.Duration(percentile(loads, 0.5))
+
+	kept := rejectOutliers(samples)
+	s.Outliers = len(samples) - len(kept)
+
+	mean, stddev := meanStdDev(kept)
+	s.Mean = time.Duration(mean)
+	s.StdDev = time.Duration(stddev)
+	s.Median = time.Duration(percentile(kept, 0.5))
+	s.P90 = time.Duration(percentile(kept, 0.9))
+
+	if n := len(kept); n > 1 {
+		half := tCritical95(n-1) * stddev / math.Sqrt(float64(n))
+		s.CILow = time.Duration(mean - half)
+		s.CIHigh = time.Duration(mean + half)
+	} else {
+		s.CILow, s.CIHigh = s.Mean, s.Mean
+	}
+	return s
+}
+
+// rejectOutliers Tukey 울타리 밖의 값을 제외한 정렬된 표본 반환
+// 표본이 4개 미만이면 사분위수가 의미 없으므로 제외하지 않습니다.
+func rejectOutliers(samples []float64) []float64 {
+	sorted := slices.Clone(samples)
+	slices.Sort(sorted)
+	if len(sorted) < 4 {
+		return sorted
+	}
+
+	q1, q3 := percentile(sorted, 0.25), percentile(sorted, 0.75)
+	iqr := q3 - q1
+	lo, hi := q1-1.5*iqr, q3+1.5*iqr
+
+	kept := sorted[:0:0]
+	for _, v := range sorted {
+		if v >= lo && v <= hi {
+			kept = append(kept, v)
+		}
+	}
+	return kept
+}
+
+// percentile 정렬된 표본의 p 분위수 (선형 보간)
+func percentile(sorted []float64, p float64) float64 {
+	if len(sorted) == 0 {
+		return 0
+	}
+	pos := p * float64(len(sorted)-1)
+	lo := int(math.Floor(pos))
+	hi := int(math.Ceil(pos))
+	frac := pos - float64(lo)
+	return sorted[lo] + (sorted[hi]-sorted[lo])*frac
+}
+
+// meanStdDev 평균과 표본 표준편차 (n-1)
+func meanStdDev(samples []float64) (float64, float64) {
+	n := float64(len(samples))
+	if n == 0 {
+		return 0, 0
+	}
+
+	var sum float64
+	for _, v := range samples {
+		sum += v
+	}
+	mean := sum / n
+	if n < 2 {
+		return mean, 0
+	}
+
+	var sq float64
+	for _, v := range samples {
+		sq += (v - mean) * (v - mean)
+	}
+	return mean, math.Sqrt(sq / (n - 1))
+}
+
+// tTable95 자유도 1~30 의 양측 95% t 임계값
+var tTable95 = [...]float64{
+	12.706, 4.303, 3.182, 2.776, 2.571, 2.447, 2.365, 2.306, 2.262, 2.228,
+	2.201, 2.179, 2.160, 2.145, 2.131, 2.120, 2.110, 2.101, 2.093, 2.086,
+	2.080, 2.074, 2.069, 2.064, 2.060, 2.056, 2.052, 2.048, 2.045, 2.042,
+}
+
+// tCritical95 자유도 df 의 양측 95% t 임계값 (30 초과는 정규분포 근사)
+func tCritical95(df int) float64 {
+	if df < 1 {
+		return math.Inf(1)
+	}
+	if df <= len(tTable95) {
+		return tTable95[df-1]
+	}
+	return 1.96
+}
@@ -0,0 +1,114 @@
+package main
+
+import (
+	"math"
+	"slices"
+	"testing"
+	"time"
+)
+
+func TestPercentile(t *testing.T) {
+	sorted := []float64{1, 2, 3, 4}
+	for _, tc := range []struct {
+		data []float64
+		p    float64
+		want float64
+	}{
+		{sorted, 0, 1},
+		{sorted, 1, 4},
+		{sorted, 0.5, 2.5}, // 위치 1.5 → 2 와 3 사이 보간
+		{sorted, 0.25, 1.75},
+		{sorted, 0.9, 3.7},
+		{[]float64{7}, 0.9, 7},
+		{nil, 0.5, 0},
+	} {
+		if got := percentile(tc.data, tc.p); math.Abs(got-tc.want) > 1e-12 {
+			t.Errorf("percentile(%v, %v) = %v, 기대 %v", tc.data, tc.p, got, tc.want)
+		}
+	}
+}
+
+func TestRejectOutliers(t *testing.T) {
+	for _, tc := range []struct {
+		name    string
+		samples []float64
+		want    []float64
+	}{
+		{"큰 이상치", []float64{12, 10, 100, 13, 11}, []float64{10, 11, 12, 13}},
+		{"작은 이상치", []float64{10, -100, 11, 12, 13}, []float64{10, 11, 12, 13}},
+		{"이상치 없음", []float64{3, 1, 4, 2}, []float64{1, 2, 3, 4}},
+		{"4개 미만은 그대로", []float64{1000, 1, 2}, []float64{1, 2, 1000}},
+		{"빈 표본", nil, []float64{}},
+	} {
+		got := rejectOutliers(tc.samples)
+		if !slices.Equal(got, tc.want) {
+			t.Errorf("%s: %v, 기대 %v", tc.name, got, tc.want)
+		}
+	}
+}
+
+func TestTCritical95(t *testing.T) {
+	for _, tc := range []struct {
+		df   int
+		want float64
+	}{
+		{1, 12.706},
+		{2, 4.303},
+		{30, 2.042},
+		{31, 1.96}, // 표 밖은 정규분포 근사
+		{1000, 1.96},
+	} {
+		if got := tCritical95(tc.df); got != tc.want {
+			t.Errorf("tCritical95(%d) = %v, 기대 %v", tc.df, got, tc.want)
+		}
+	}
+	if got := tCritical95(0); !math.IsInf(got, 1) {
+		t.Errorf("tCritical95(0) = %v, 기대 +Inf", got)
+	}
+}
+
+func TestSummarize(t *testing.T) {
+	// 10~18ms 다섯 번과 이상치 100ms 한 번: 평균 14ms, 표본 표준편차 √10 ms
+	var group []BenchmarkResult
+	for _, ms := range []int{10, 12, 14, 16, 18, 100} {
+		group = append(group, BenchmarkResult{
+			Algorithm:   "quicksort",
+			DataSize:    1000,
+			Duration:    time.Duration(ms) * time.Millisecond,
+			MemoryUsage: 600,
+		})
+	}
+	s := summarize(group)
+
+	if s.Runs != 6 || s.Outliers != 1 {
+		t.Fatalf("Runs=%d Outliers=%d, 기대 6, 1", s.Runs, s.Outliers)
+	}
+	if s.Algorithm != "quicksort" || s.DataSize != 1000 || s.MeanMemory != 600 {
+		t.Errorf("조건 필드가 첫 결과와 다름: %+v", s)
+	}
+
+	ms := float64(time.Millisecond)
+	half := 2.776 * math.Sqrt(10) / math.Sqrt(5) * ms // 자유도 4
+	for _, tc := range []struct {
+		name string
+		got  time.Duration
+		want float64
+	}{
+		{"Mean", s.Mean, 14 * ms},
+		{"Median", s.Median, 14 * ms},
+		{"P90", s.P90, 17.2 * ms},
+		{"StdDev", s.StdDev, math.Sqrt(10) * ms},
+		{"CILow", s.CILow, 14*ms - half},
+		{"CIHigh", s.CIHigh, 14*ms + half},
+	} {
+		if math.Abs(float64(tc.got)-tc.want) > 1 {
+			t.Errorf("%s = %v, 기대 %v", tc.name, tc.got, time.Duration(tc.want))
+		}
+	}
+
+	// 한 번만 측정하면 신뢰구간은 평균 한 점
+	one := summarize(group[:1])
+	if one.CILow != one.Mean || one.CIHigh != one.Mean || one.StdDev != 0 {
+		t.Errorf("1회 측정: %+v", one)
+	}
+}
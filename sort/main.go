@@ -1,90 +1,208 @@
 package main
 
 import (
+	"errors"
+	"flag"
 	"fmt"
+	"io/fs"
 	"os"
 	"runtime"
+	"text/tabwriter"
 	"time"
+
+	"gotest/sort/sorts"
 )
 
+// 외부 정렬 메모리 예산 (10만개 * 8바이트보다 작게 잡아 런 파일이 생기도록 함)
+const externalMemoryBudget = 256 * 1024
+
 func main() {
-	fmt.Println("정렬 알고리즘 벤치마크 시작...")
-	fmt.Printf("CPU 코어 수: %d\n", runtime.NumCPU())
-	fmt.Printf("GOMAXPROCS: %d\n\n", runtime.GOMAXPROCS(0))
+	matrixPath := flag.String("matrix", "", "벤치마크 조건 행렬 JSON 파일 (비우면 내장 matrix.json)")
+	list := flag.Bool("list", false, "등록된 알고리즘 목록을 출력하고 종료")
+	compareMode := flag.Bool("compare", false, "두 결과 JSON 비교: -compare [-threshold 0.05] old.json new.json\n"+
+		"조건별 측정이 양쪽 모두 4회 이상이어야 p < 0.05 가 가능합니다. 3회로 측정한 결과(저장소의 benchmark_results.json 등)와\n"+
+		"비교하면 판단할 수 없다는 오류와 함께 종료 코드 2 로 끝나므로 기준 결과도 -runs 5 이상으로 다시 측정하세요")
+	var cmpCfg compareConfig
+	flag.Float64Var(&cmpCfg.Threshold, "threshold", 0.05, "-compare 에서 회귀로 판단할 중앙값 증가율 (0.05 = 5%)")
+	flag.Float64Var(&cmpCfg.Alpha, "alpha", 0.05, "-compare 의 Mann-Whitney U 유의수준")
+	distSpec := flag.String("dist", "", "모든 스위트의 입력 분포 덮어쓰기 (쉼표 구분, all = 전체)")
+	var override harnessConfig
+	flag.IntVar(&override.Warmup, "warmup", defaultHarnessConfig.Warmup, "결과에서 제외하는 예열 실행 수")
+	flag.IntVar(&override.MinRuns, "runs", defaultHarnessConfig.MinRuns, "조건별 최소 측정 횟수")
+	flag.DurationVar(&override.MinTime, "mintime", defaultHarnessConfig.MinTime, "조건별 최소 누적 측정 시간 (0 이면 -runs 만 사용)")
+	flag.IntVar(&override.MaxRuns, "maxruns", defaultHarnessConfig.MaxRuns, "-mintime 사용 시 조건별 최대 측정 횟수")
+	flag.DurationVar(&override.Pause, "pause", defaultHarnessConfig.Pause, "실행 사이 안정화 대기 시간")
+	oversampling := flag.Int("oversampling", 0, "병렬 샘플 정렬의 버킷당 표본 수 (0 이면 행렬 설정 또는 기본값)")
+	calibrateMode := flag.Bool("calibrate", false, "현재 기계에서 정렬 임계값을 측정해 -tuning 경로에 프로파일로 저장")
+	sweep := flag.Bool("sweep", false, "병렬 알고리즘만 GOMAXPROCS 1, 2, 4, … CPU 코어 수로 반복 측정해 확장성 계산")
+	baseCase := flag.String("basecase", "", "작은 구간 정렬 방식: insertion, network (비우면 튜닝 프로파일 또는 기본값)")
+	baseCaseReport := flag.Bool("basecase-report", false, "삽입정렬과 정렬 네트워크 기저 정렬의 크기별 전체 정렬 시간을 비교하고 종료")
+	tuningPath := flag.String("tuning", "", "정렬 임계값 프로파일 경로 (비우면 "+sorts.TuningProfileEnv+" 또는 사용자 설정 디렉터리)")
+	flag.Parse()
 
-	// 워커 풀 초기화
-	initWorkerPool()
+	if *list {
+		printSorters()
+		return
+	}
 
-	var allResults []BenchmarkResult
-	algorithms := []string{"quicksort", "parallel_quicksort", "mergesort", "parallel_mergesort"}
+	// 비교 모드: 회귀가 있으면 1, 입력 오류는 2 로 종료
+	if *compareMode {
+		if flag.NArg() != 2 {
+			fmt.Println("사용법: -compare [-threshold 0.05] [-alpha 0.05] old.json new.json")
+			os.Exit(2)
+		}
+		regressed, err := runCompare(os.Stdout, flag.Arg(0), flag.Arg(1), cmpCfg)
+		if err != nil {
+			fmt.Println(err)
+			os.Exit(2)
+		}
+		if regressed {
+			os.Exit(1)
+		}
+		return
+	}
 
-	// 1. 1천개 데이터 - 인메모리
-	fmt.Println("1천개 데이터 (인메모리) 테스트 중...")
-	data1k := generateRandomData(1000)
+	// 보정 모드: 측정 횟수는 -runs 를 지정하지 않으면 calibrationRuns
+	if *calibrateMode {
+		path, runs := *tuningPath, calibrationRuns
+		if path == "" {
+			path = sorts.TuningProfilePath()
+		}
+		flag.Visit(func(f *flag.Flag) {
+			if f.Name == "runs" {
+				runs = override.MinRuns
+			}
+		})
+		if err := calibrate(os.Stdout, path, runs); err != nil {
+			fmt.Println(err)
+			os.Exit(2)
+		}
+		return
+	}
 
-	for _, algo := range algorithms {
-		for run := 1; run <= 3; run++ {
-			fmt.Printf("  %s - 테스트 %d\n", algo, run)
-			result := runBenchmark(algo, data1k, false)
-			result.TestRun = run
-			allResults = append(allResults, result)
-			time.Sleep(50 * time.Millisecond) // 시스템 안정화 시간 단축
+	// 지정한 프로파일은 읽지 못하면 오류, 지정하지 않으면 기본 경로의 프로파일을 읽고
+	// 없으면 기본값 사용 (라이브러리는 기본 경로를 스스로 읽지 않음)
+	if *tuningPath != "" {
+		if _, err := sorts.LoadTuningProfile(*tuningPath); err != nil {
+			fmt.Println(err)
+			os.Exit(2)
+		}
+	} else if path := sorts.TuningProfilePath(); path != "" {
+		if _, err := sorts.LoadTuningProfile(path); err != nil && !errors.Is(err, fs.ErrNotExist) {
+			fmt.Printf("튜닝 프로파일을 읽지 못해 기본값을 사용합니다: %v\n", err)
+		}
+	}
+	if *baseCase != "" {
+		if err := sorts.SetBaseCase(sorts.BaseCase(*baseCase)); err != nil {
+			fmt.Println(err)
+			os.Exit(2)
 		}
 	}
 
-	// 2. 1만개 데이터 - 인메모리
-	fmt.Println("1만개 데이터 (인메모리) 테스트 중...")
-	data10k := generateRandomData(10000)
+	// 기저 정렬 비교 모드: 측정 횟수는 -runs 를 지정하지 않으면 calibrationRuns
+	if *baseCaseReport {
+		runs := calibrationRuns
+		flag.Visit(func(f *flag.Flag) {
+			if f.Name == "runs" {
+				runs = override.MinRuns
+			}
+		})
+		if err := reportBaseCases(os.Stdout, max(1, runs)); err != nil {
+			fmt.Println(err)
+			os.Exit(2)
+		}
+		return
+	}
 
-	for _, algo := range algorithms {
-		for run := 1; run <= 3; run++ {
-			fmt.Printf("  %s - 테스트 %d\n", algo, run)
-			result := runBenchmark(algo, data10k, false)
-			result.TestRun = run
-			allResults = append(allResults, result)
-			time.Sleep(50 * time.Millisecond)
+	matrix, err := loadMatrix(*matrixPath)
+	if err == nil {
+		// 명시적으로 지정한 플래그만 행렬 설정을 덮어씀 (결과 JSON 에는 덮어쓴 행렬이 기록됨)
+		flag.Visit(func(f *flag.Flag) {
+			switch f.Name {
+			case "warmup":
+				matrix.Warmup = override.Warmup
+			case "runs":
+				matrix.Runs = override.MinRuns
+			case "mintime":
+				matrix.MinTime = jsonDuration(override.MinTime)
+			case "maxruns":
+				matrix.MaxRuns = override.MaxRuns
+			case "pause":
+				matrix.Pause = jsonDuration(override.Pause)
+			case "oversampling":
+				matrix.SampleOversampling = *oversampling
+			}
+		})
+		if *distSpec != "" {
+			err = matrix.overrideDistributions(*distSpec)
+		} else {
+			err = matrix.validate()
+		}
+	}
+	if err == nil && *sweep {
+		if err = matrix.applySweep(); err == nil && len(matrix.GOMAXPROCS) < 2 {
+			fmt.Println("CPU 코어가 하나뿐이라 GOMAXPROCS=1 만 측정합니다 (확장성은 계산하지 않음)")
 		}
 	}
+	if err != nil {
+		fmt.Println(err)
+		os.Exit(2)
+	}
+	cfg := matrix.harness()
+	sampleSortOptions.Oversampling = matrix.SampleOversampling
 
-	// 3. 10만개 데이터 - 파일 방식
-	fmt.Println("10만개 데이터 (파일) 테스트 중...")
-	data100k := generateRandomData(100000)
-	filename := "test_data_100k.txt"
+	fmt.Println("정렬 알고리즘 벤치마크 시작...")
+	fmt.Printf("CPU 코어 수: %d\n", runtime.NumCPU())
+	fmt.Printf("GOMAXPROCS: %d\n", runtime.GOMAXPROCS(0))
+	fmt.Printf("정렬 임계값: %s\n\n", tuningDescription())
 
-	if err := writeDataToFile(data100k, filename); err != nil {
-		fmt.Printf("파일 쓰기 오류: %v\n", err)
-		return
-	}
-	defer os.Remove(filename)
+	// 워커 풀 초기화
+	sorts.InitWorkerPool()
 
-	for _, algo := range algorithms {
-		for run := 1; run <= 3; run++ {
-			fmt.Printf("  %s - 테스트 %d\n", algo, run)
+	var allResults []BenchmarkResult
+	defaultProcs := runtime.GOMAXPROCS(0)
 
-			// 매번 파일에서 읽기
-			fileData, err := readDataFromFile(filename)
-			if err != nil {
-				fmt.Printf("파일 읽기 오류: %v\n", err)
-				continue
-			}
+	for _, c := range matrix.expand() {
+		procs := c.Procs
+		if procs == 0 {
+			procs = defaultProcs
+		}
+		runtime.GOMAXPROCS(procs)
+		sorts.SetParallelism(c.Procs) // 스케줄러 워커 수와 워커 풀 크기도 맞춤 (0 이면 기본값)
 
-			result := runBenchmark(algo, fileData, true)
-			result.TestRun = run
-			allResults = append(allResults, result)
-			time.Sleep(50 * time.Millisecond)
+		if c.K > 0 {
+			fmt.Printf("%d개 데이터에서 %d개 선택 (%s 분포, GOMAXPROCS=%d) 테스트 중...\n",
+				c.Size, c.K, c.Distribution, procs)
+		} else if c.KeyType != "" {
+			fmt.Printf("%d개 %s 키 (%s 분포, GOMAXPROCS=%d) 테스트 중...\n",
+				c.Size, displayName(keyTypeNames, c.KeyType), c.Distribution, procs)
+		} else if c.Records {
+			fmt.Printf("%d개 (키, ID) 레코드 (%s 분포, GOMAXPROCS=%d) 테스트 중...\n",
+				c.Size, c.Distribution, procs)
+		} else {
+			fmt.Printf("%d개 데이터 (%s, %s 분포, GOMAXPROCS=%d) 테스트 중...\n",
+				c.Size, displayName(storageNames, c.Storage), c.Distribution, procs)
+		}
+		results, err := runCase(cfg, c)
+		if err != nil {
+			fmt.Printf("테스트 오류: %v\n", err)
 		}
+		allResults = append(allResults, results...)
 	}
+	runtime.GOMAXPROCS(defaultProcs)
+	sorts.SetParallelism(0)
 
 	// 결과 저장
 	fmt.Println("결과 저장 중...")
+	summaries := computeSummaries(allResults)
 
-	if err := saveResultsToMarkdown(allResults); err != nil {
+	if err := saveResultsToMarkdown(allResults, summaries); err != nil {
 		fmt.Printf("마크다운 저장 오류: %v\n", err)
 	} else {
 		fmt.Println("benchmark_results.md 파일이 생성되었습니다.")
 	}
 
-	if err := saveResultsToJSON(allResults); err != nil {
+	if err := saveResultsToJSON(matrix, allResults, summaries); err != nil {
 		fmt.Printf("JSON 저장 오류: %v\n", err)
 	} else {
 		fmt.Println("benchmark_results.json 파일이 생성되었습니다.")
@@ -92,3 +210,112 @@ func main() {
 
 	fmt.Println("벤치마크 완료!")
 }
+
+// runCase 한 조건(저장방식, 크기, 분포)의 입력을 만들고 모든 알고리즘을 반복 측정
+// file 저장방식은 입력을 파일로 써두고 매 측정마다 파일에서 다시 읽습니다.
+// 키 정렬 조건은 같은 정수 입력을 kvdb 형식의 키로 바꿔 사용하고,
+// 레코드 정렬 조건은 정수 입력을 키로 그대로 씁니다.
+func runCase(cfg harnessConfig, c benchmarkCase) ([]BenchmarkResult, error) {
+	data, err := generateData(c.Distribution, c.Size)
+	if err != nil {
+		return nil, fmt.Errorf("데이터 생성 오류: %w", err)
+	}
+
+	var keys [][]byte
+	if c.KeyType != "" {
+		keys = generateKeys(data)
+	}
+
+	var filename string
+	if c.Storage != "memory" {
+		filename = datasetFilename(c.Storage, c.Distribution, c.Size)
+		if err := writeDataset(c.Storage, data, filename); err != nil {
+			return nil, fmt.Errorf("파일 쓰기 오류: %w", err)
+		}
+		defer os.Remove(filename)
+	}
+
+	var allResults []BenchmarkResult
+	for _, algo := range c.Algorithms {
+		results, err := repeatBenchmark(cfg, algo, func() (BenchmarkResult, error) {
+			if c.K > 0 {
+				selector, _ := lookupSelector(algo) // 행렬 검증에서 확인됨
+				return runSelectBenchmark(selector, data, c.K, c.Distribution), nil
+			}
+			if c.KeyType != "" {
+				sorter, _ := lookupKeySorter(algo) // 행렬 검증에서 확인됨
+				return runKeyBenchmark(sorter, keys, c.KeyType, c.Distribution), nil
+			}
+			if c.Records {
+				sorter, _ := lookupRecordSorter(algo) // 행렬 검증에서 확인됨
+				return runRecordBenchmark(sorter, data, c.Distribution), nil
+			}
+			if algo == externalSortName {
+				// 외부 정렬 - 메모리 예산보다 큰 파일을 청크 단위로 정렬 후 병합
+				return runExternalBenchmark(filename, c.Size, c.Distribution, externalMemoryBudget)
+			}
+
+			sorter, _ := lookupSorter(algo) // 행렬 검증에서 확인됨
+			if c.Storage != "memory" {
+				// 매번 파일에서 읽기 (읽는 시간은 정렬 시간과 따로 기록)
+				start := time.Now()
+				fileData, err := loadDataset(c.Storage, filename)
+				if err != nil {
+					return BenchmarkResult{}, err
+				}
+				loadDuration := time.Since(start)
+
+				result := runBenchmark(sorter, fileData, c.Storage, c.Distribution)
+				result.LoadDuration = loadDuration
+				return result, nil
+			}
+			return runBenchmark(sorter, data, c.Storage, c.Distribution), nil
+		})
+		if err != nil {
+			fmt.Printf("%s 측정 오류: %v\n", algo, err)
+		}
+		allResults = append(allResults, results...)
+	}
+	return allResults, nil
+}
+
+// printSorters 등록된 알고리즘 목록 출력 (-list)
+func printSorters() {
+	w := tabwriter.NewWriter(os.Stdout, 0, 4, 2, ' ', 0)
+	fmt.Fprintln(w, "이름\t알고리즘\t제자리\t안정")
+	for _, s := range Sorters() {
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\n", s.Name(), s.DisplayName(), yesNo(s.InPlace()), yesNo(s.Stable()))
+	}
+	fmt.Fprintf(w, "%s\t%s\t-\t-\n", externalSortName, externalSortDisplayName)
+
+	fmt.Fprintln(w, "\n선택 (행렬의 k 지정 스위트)\t\t\t")
+	for _, s := range Selectors() {
+		fmt.Fprintf(w, "%s\t%s\t%s\t-\n", s.Name(), s.DisplayName(), orderedNote(s.Ordered()))
+	}
+
+	fmt.Fprintln(w, "\n키 정렬 (행렬의 key_types 지정 스위트)\t\t\t")
+	for _, s := range KeySorters() {
+		name := s.DisplayName()
+		if !s.SupportsKeyType("string") {
+			name += " ([]byte 키만)"
+		}
+		fmt.Fprintf(w, "%s\t%s\t%s\t-\n", s.Name(), name, yesNo(true))
+	}
+
+	fmt.Fprintln(w, "\n레코드 정렬 (행렬의 records 지정 스위트)\t\t\t")
+	for _, s := range RecordSorters() {
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\n", s.Name(), s.DisplayName(), yesNo(true), yesNo(true))
+	}
+	w.Flush()
+}
+
+// mustVerify 정렬 결과 검증에 실패하면 벤치마크 전체를 즉시 중단
+// 틀린 결과의 측정값은 의미가 없으므로 결과 파일도 남기지 않습니다.
+func mustVerify(result BenchmarkResult) {
+	if result.Verified {
+		return
+	}
+	fmt.Fprintf(os.Stderr, "\n❌ 검증 실패: %s (%d개, %s, %s 분포, 테스트 %d): %s\n",
+		result.Algorithm, result.DataSize, result.StorageType, result.Distribution, result.TestRun, result.Error)
+	os.Exit(1)
+}
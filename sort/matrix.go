@@ -0,0 +1,386 @@
+package main
+
+import (
+	"bytes"
+	_ "embed"
+	"encoding/json"
+	"fmt"
+	"os"
+	"slices"
+	"strings"
+	"time"
+)
+
+// defaultMatrixJSON -matrix 를 지정하지 않았을 때 사용하는 기본 행렬
+//
+//go:embed matrix.json
+var defaultMatrixJSON []byte
+
+// benchmarkMatrix 벤치마크 조건 행렬 (JSON 파일)
+// 각 스위트는 저장방식 × 크기 × 분포의 모든 조합에 대해 나열된 알고리즘을 실행하고,
+// 전체 스위트를 GOMAXPROCS 값마다 반복합니다. 생략한 반복 설정은 defaultHarnessConfig 를 따릅니다.
+type benchmarkMatrix struct {
+	GOMAXPROCS []int        `json:"gomaxprocs,omitempty"` // 0 이면 현재 값 그대로
+	Warmup     int          `json:"warmup"`
+	Runs       int          `json:"runs"`
+	MinTime    jsonDuration `json:"min_time,omitempty"`
+	MaxRuns    int          `json:"max_runs,omitempty"`
+	Pause      jsonDuration `json:"pause"`
+
+	// 병렬 샘플 정렬의 버킷당 표본 수 (0 이면 sorts.DefaultSampleOversampling)
+	SampleOversampling int `json:"sample_oversampling,omitempty"`
+
+	Suites []matrixSuite `json:"suites"`
+}
+
+// matrixSuite 한 묶음의 조건 조합
+type matrixSuite struct {
+	Name          string   `json:"name,omitempty"`
+	Storage       []string `json:"storage"`       // "memory", "file", "binary"
+	Sizes         []int    `json:"sizes"`         // 데이터 크기
+	Distributions []string `json:"distributions"` // 분포 이름 ("all" = 전체)
+	Algorithms    []string `json:"algorithms"`    // 알고리즘 이름 ("all" = 등록된 전체)
+
+	// K 를 지정하면 정렬 대신 선택 벤치마크(가장 작은 k 개 고르기)를 k 값마다 실행하며,
+	// 이때 algorithms 는 등록된 Selector 이름입니다. 인메모리에서만 실행합니다.
+	K []int `json:"k,omitempty"`
+
+	// KeyTypes 를 지정하면 정수 대신 kvdb 의 generateKey 와 같은 형식의 키를 키 타입마다 정렬하며,
+	// 이때 algorithms 는 등록된 KeySorter 이름입니다. 인메모리에서만 실행합니다.
+	KeyTypes []string `json:"key_types,omitempty"` // "bytes", "string"
+
+	// Records 가 true 면 정수 입력을 키로, 입력 순서를 ID 로 하는 (키, ID) 레코드를 안정 정렬하며,
+	// 이때 algorithms 는 등록된 RecordSorter 이름입니다. 결과는 안정성까지 검증합니다. 인메모리에서만 실행합니다.
+	Records bool `json:"records,omitempty"`
+}
+
+// benchmarkCase 행렬을 펼친 실행 단위 (같은 입력 데이터를 공유하는 알고리즘 묶음)
+type benchmarkCase struct {
+	Procs        int // 0 이면 현재 GOMAXPROCS
+	Storage      string
+	Size         int
+	Distribution string
+	K            int    // 선택 벤치마크의 k (0 이면 정렬)
+	KeyType      string // 키 정렬 벤치마크의 키 타입 (빈 값이면 정수 정렬)
+	Records      bool   // 레코드 안정 정렬 벤치마크
+	Algorithms   []string
+}
+
+// jsonDuration "50ms" 같은 문자열로 표현하는 time.Duration
+type jsonDuration time.Duration
+
+func (d jsonDuration) MarshalJSON() ([]byte, error) {
+	return json.Marshal(time.Duration(d).String())
+}
+
+func (d *jsonDuration) UnmarshalJSON(b []byte) error {
+	var s string
+	if err := json.Unmarshal(b, &s); err != nil {
+		return fmt.Errorf("시간은 \"50ms\" 같은 문자열이어야 합니다: %s", b)
+	}
+	v, err := time.ParseDuration(s)
+	if err != nil {
+		return err
+	}
+	*d = jsonDuration(v)
+	return nil
+}
+
+// loadMatrix 행렬 파일 읽기 (path 가 비어 있으면 내장 기본 행렬)
+func loadMatrix(path string) (*benchmarkMatrix, error) {
+	raw := defaultMatrixJSON
+	if path != "" {
+		var err error
+		if raw, err = os.ReadFile(path); err != nil {
+			return nil, err
+		}
+	}
+
+	m := &benchmarkMatrix{
+		Warmup:  defaultHarnessConfig.Warmup,
+		Runs:    defaultHarnessConfig.MinRuns,
+		MinTime: jsonDuration(defaultHarnessConfig.MinTime),
+		MaxRuns: defaultHarnessConfig.MaxRuns,
+		Pause:   jsonDuration(defaultHarnessConfig.Pause),
+	}
+	dec := json.NewDecoder(bytes.NewReader(raw))
+	dec.DisallowUnknownFields()
+	if err := dec.Decode(m); err != nil {
+		return nil, fmt.Errorf("행렬 파일 파싱 오류: %w", err)
+	}
+	if err := m.validate(); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// harness 행렬의 반복 측정 설정
+func (m *benchmarkMatrix) harness() harnessConfig {
+	return harnessConfig{
+		Warmup:  m.Warmup,
+		MinRuns: m.Runs,
+		MinTime: time.Duration(m.MinTime),
+		MaxRuns: m.MaxRuns,
+		Pause:   time.Duration(m.Pause),
+	}
+}
+
+// overrideDistributions 모든 스위트의 분포를 spec 으로 교체 (-dist 플래그)
+func (m *benchmarkMatrix) overrideDistributions(spec string) error {
+	if _, err := parseDistributions(spec); err != nil {
+		return err
+	}
+	for i := range m.Suites {
+		m.Suites[i].Distributions = []string{spec}
+	}
+	return m.validate()
+}
+
+// validate 실행 전에 잘못된 조건을 모두 걸러냄 (측정 도중 실패하지 않도록)
+func (m *benchmarkMatrix) validate() error {
+	if m.Warmup < 0 || m.Runs < 1 || m.MaxRuns < m.Runs {
+		return fmt.Errorf("반복 설정이 잘못되었습니다: warmup=%d runs=%d max_runs=%d", m.Warmup, m.Runs, m.MaxRuns)
+	}
+	if m.SampleOversampling < 0 {
+		return fmt.Errorf("sample_oversampling 은 0 이상이어야 합니다: %d", m.SampleOversampling)
+	}
+	for _, p := range m.GOMAXPROCS {
+		if p < 0 {
+			return fmt.Errorf("GOMAXPROCS 는 0 이상이어야 합니다: %d", p)
+		}
+	}
+	if len(m.Suites) == 0 {
+		return fmt.Errorf("스위트가 하나도 없습니다")
+	}
+
+	for i, s := range m.Suites {
+		name := s.Name
+		if name == "" {
+			name = fmt.Sprintf("#%d", i+1)
+		}
+		if len(s.Storage) == 0 || len(s.Sizes) == 0 || len(s.Algorithms) == 0 {
+			return fmt.Errorf("스위트 %s: storage, sizes, algorithms 는 비어 있을 수 없습니다", name)
+		}
+
+		dists, err := s.distributions()
+		if err != nil {
+			return fmt.Errorf("스위트 %s: %w", name, err)
+		}
+		for _, size := range s.Sizes {
+			if size < 1 {
+				return fmt.Errorf("스위트 %s: 크기는 1 이상이어야 합니다: %d", name, size)
+			}
+			if size > maxAdversarial && slices.Contains(dists, distAdversarial) {
+				return fmt.Errorf("스위트 %s: 적대적 입력은 %d개 이하만 생성할 수 있습니다: %d", name, maxAdversarial, size)
+			}
+		}
+
+		if len(s.K) > 0 && len(s.KeyTypes) > 0 {
+			return fmt.Errorf("스위트 %s: k 와 key_types 는 함께 지정할 수 없습니다", name)
+		}
+		if s.Records && (len(s.K) > 0 || len(s.KeyTypes) > 0) {
+			return fmt.Errorf("스위트 %s: records 는 k, key_types 와 함께 지정할 수 없습니다", name)
+		}
+		if s.Records {
+			if err := s.validateRecords(name); err != nil {
+				return err
+			}
+			continue
+		}
+		if len(s.K) > 0 {
+			if err := s.validateSelect(name); err != nil {
+				return err
+			}
+			continue
+		}
+		if len(s.KeyTypes) > 0 {
+			if err := s.validateKeys(name); err != nil {
+				return err
+			}
+			continue
+		}
+
+		for _, storage := range s.Storage {
+			if _, ok := storageNames[storage]; !ok {
+				return fmt.Errorf("스위트 %s: 알 수 없는 저장방식: %q", name, storage)
+			}
+			for _, algo := range s.algorithms() {
+				if !knownAlgorithm(algo) {
+					return fmt.Errorf("스위트 %s: 알 수 없는 알고리즘: %q", name, algo)
+				}
+				if algo == externalSortName && storage != "file" {
+					return fmt.Errorf("스위트 %s: %s 는 file 저장방식에서만 실행할 수 있습니다", name, externalSortName)
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// validateSelect 선택 스위트 검사
+func (s matrixSuite) validateSelect(name string) error {
+	for _, storage := range s.Storage {
+		if storage != "memory" {
+			return fmt.Errorf("스위트 %s: 선택 벤치마크는 memory 저장방식에서만 실행할 수 있습니다", name)
+		}
+	}
+	for _, algo := range s.algorithms() {
+		if _, ok := lookupSelector(algo); !ok {
+			return fmt.Errorf("스위트 %s: 알 수 없는 선택 알고리즘: %q", name, algo)
+		}
+	}
+	for _, k := range s.K {
+		for _, size := range s.Sizes {
+			if k < 1 || k > size {
+				return fmt.Errorf("스위트 %s: k 는 1 이상 크기(%d) 이하여야 합니다: %d", name, size, k)
+			}
+		}
+	}
+	return nil
+}
+
+// validateKeys 키 정렬 스위트 검사
+func (s matrixSuite) validateKeys(name string) error {
+	for _, storage := range s.Storage {
+		if storage != "memory" {
+			return fmt.Errorf("스위트 %s: 키 정렬 벤치마크는 memory 저장방식에서만 실행할 수 있습니다", name)
+		}
+	}
+	for _, keyType := range s.KeyTypes {
+		if _, ok := keyTypeNames[keyType]; !ok {
+			return fmt.Errorf("스위트 %s: 알 수 없는 키 타입: %q", name, keyType)
+		}
+	}
+	for _, algo := range s.algorithms() {
+		sorter, ok := lookupKeySorter(algo)
+		if !ok {
+			return fmt.Errorf("스위트 %s: 알 수 없는 키 정렬 알고리즘: %q", name, algo)
+		}
+		// "all" 로 펼친 알고리즘은 지원하는 키 타입에서만 실행하므로 직접 지정한 경우만 검사
+		if !slices.Contains(s.Algorithms, algo) {
+			continue
+		}
+		for _, keyType := range s.KeyTypes {
+			if !sorter.SupportsKeyType(keyType) {
+				return fmt.Errorf("스위트 %s: 키 정렬 알고리즘 %q 는 %s 키를 지원하지 않습니다", name, algo, keyType)
+			}
+		}
+	}
+	return nil
+}
+
+// validateRecords 레코드 정렬 스위트 검사
+func (s matrixSuite) validateRecords(name string) error {
+	for _, storage := range s.Storage {
+		if storage != "memory" {
+			return fmt.Errorf("스위트 %s: 레코드 정렬 벤치마크는 memory 저장방식에서만 실행할 수 있습니다", name)
+		}
+	}
+	for _, algo := range s.algorithms() {
+		if _, ok := lookupRecordSorter(algo); !ok {
+			return fmt.Errorf("스위트 %s: 알 수 없는 레코드 정렬 알고리즘: %q", name, algo)
+		}
+	}
+	return nil
+}
+
+// distributions 스위트의 분포 목록 ("all" 포함 가능)
+func (s matrixSuite) distributions() ([]string, error) {
+	return parseDistributions(strings.Join(s.Distributions, ","))
+}
+
+// algorithms 스위트의 알고리즘 목록
+// "all" 은 정렬 스위트에서는 등록된 모든 Sorter, 선택 스위트에서는 모든 Selector,
+// 키 정렬 스위트에서는 모든 KeySorter, 레코드 정렬 스위트에서는 모든 RecordSorter 로 펼칩니다.
+func (s matrixSuite) algorithms() []string {
+	var algos []string
+	for _, algo := range s.Algorithms {
+		switch {
+		case algo != "all":
+			algos = append(algos, algo)
+		case len(s.K) > 0:
+			for _, selector := range Selectors() {
+				algos = append(algos, selector.Name())
+			}
+		case len(s.KeyTypes) > 0:
+			for _, sorter := range KeySorters() {
+				algos = append(algos, sorter.Name())
+			}
+		case s.Records:
+			for _, sorter := range RecordSorters() {
+				algos = append(algos, sorter.Name())
+			}
+		default:
+			for _, sorter := range Sorters() {
+				algos = append(algos, sorter.Name())
+			}
+		}
+	}
+	return algos
+}
+
+// algorithmsFor 키 타입 keyType 에서 실행할 알고리즘 목록
+// 키 정렬 스위트에서는 그 키 타입을 지원하지 않는 KeySorter 를 뺍니다.
+func (s matrixSuite) algorithmsFor(keyType string) []string {
+	algos := s.algorithms()
+	if keyType == "" {
+		return algos
+	}
+	return slices.DeleteFunc(algos, func(algo string) bool {
+		sorter, ok := lookupKeySorter(algo)
+		return ok && !sorter.SupportsKeyType(keyType)
+	})
+}
+
+// ks 스위트의 k 목록 (정렬 스위트는 0 하나)
+func (s matrixSuite) ks() []int {
+	if len(s.K) == 0 {
+		return []int{0}
+	}
+	return s.K
+}
+
+// keyTypes 스위트의 키 타입 목록 (키 정렬 스위트가 아니면 빈 값 하나)
+func (s matrixSuite) keyTypes() []string {
+	if len(s.KeyTypes) == 0 {
+		return []string{""}
+	}
+	return s.KeyTypes
+}
+
+// expand 행렬을 실행 순서대로 펼침
+// 순서: GOMAXPROCS → 스위트 → 저장방식 → 크기 → 선택 k / 키 타입 → 분포
+func (m *benchmarkMatrix) expand() []benchmarkCase {
+	procs := m.GOMAXPROCS
+	if len(procs) == 0 {
+		procs = []int{0}
+	}
+
+	var cases []benchmarkCase
+	for _, p := range procs {
+		for _, s := range m.Suites {
+			dists, _ := s.distributions() // validate 에서 확인됨
+			for _, storage := range s.Storage {
+				for _, size := range s.Sizes {
+					for _, k := range s.ks() {
+						for _, keyType := range s.keyTypes() {
+							for _, dist := range dists {
+								cases = append(cases, benchmarkCase{
+									Procs:        p,
+									Storage:      storage,
+									Size:         size,
+									Distribution: dist,
+									K:            k,
+									KeyType:      keyType,
+									Records:      s.Records,
+									Algorithms:   s.algorithmsFor(keyType),
+								})
+							}
+						}
+					}
+				}
+			}
+		}
+	}
+	return cases
+}
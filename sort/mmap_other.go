@@ -0,0 +1,14 @@
+//go:build !unix
+
+package main
+
+import "os"
+
+// mapFile 메모리 매핑을 지원하지 않는 플랫폼에서는 파일 전체를 읽음
+func mapFile(filename string) ([]byte, func() error, error) {
+	buf, err := os.ReadFile(filename)
+	if err != nil {
+		return nil, nil, err
+	}
+	return buf, func() error { return nil }, nil
+}
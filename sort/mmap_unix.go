@@ -0,0 +1,32 @@
+//go:build unix
+
+package main
+
+import (
+	"os"
+	"syscall"
+)
+
+// mapFile 파일 전체를 읽기 전용으로 메모리 매핑
+// 반환된 함수로 매핑을 해제하며, 그 뒤에는 슬라이스를 사용하면 안 됩니다.
+func mapFile(filename string) ([]byte, func() error, error) {
+	file, err := os.Open(filename)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer file.Close()
+
+	info, err := file.Stat()
+	if err != nil {
+		return nil, nil, err
+	}
+	if info.Size() == 0 {
+		return nil, func() error { return nil }, nil // 길이 0 은 매핑할 수 없음
+	}
+
+	buf, err := syscall.Mmap(int(file.Fd()), 0, int(info.Size()), syscall.PROT_READ, syscall.MAP_SHARED)
+	if err != nil {
+		return nil, nil, &os.PathError{Op: "mmap", Path: filename, Err: err}
+	}
+	return buf, func() error { return syscall.Munmap(buf) }, nil
+}
@@ -0,0 +1,382 @@
+package main
+
+import (
+	"bytes"
+	"cmp"
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+
+	"gotest/sort/sorts"
+)
+
+// Sorter 벤치마크에 등록하는 []int 정렬 알고리즘
+// 새 알고리즘은 Register 로 등록하면 행렬, 보고서, CLI 에서 바로 사용할 수 있습니다.
+type Sorter interface {
+	Name() string        // 행렬 파일과 JSON 결과에 쓰는 식별자
+	DisplayName() string // 마크다운 출력용 이름
+	InPlace() bool       // true 면 입력을 직접 정렬, false 면 정렬된 새 슬라이스를 반환
+	Stable() bool        // 같은 값의 상대 순서를 유지하는지
+	Sort(data []int) []int
+}
+
+// funcSorter 함수 하나로 구현한 Sorter
+type funcSorter struct {
+	name, displayName string
+	inPlace, stable   bool
+	sort              func([]int) []int
+}
+
+func (s funcSorter) Name() string          { return s.name }
+func (s funcSorter) DisplayName() string   { return s.displayName }
+func (s funcSorter) InPlace() bool         { return s.inPlace }
+func (s funcSorter) Stable() bool          { return s.stable }
+func (s funcSorter) Sort(data []int) []int { return s.sort(data) }
+
+// InPlaceSorter 입력을 직접 정렬하는 함수로 Sorter 생성
+func InPlaceSorter(name, displayName string, stable bool, fn func([]int)) Sorter {
+	return funcSorter{name, displayName, true, stable, func(data []int) []int {
+		fn(data)
+		return data
+	}}
+}
+
+// CopySorter 정렬된 새 슬라이스를 반환하는 함수로 Sorter 생성
+func CopySorter(name, displayName string, stable bool, fn func([]int) []int) Sorter {
+	return funcSorter{name, displayName, false, stable, fn}
+}
+
+// Selector 선택 벤치마크에 등록하는 알고리즘 (가장 작은 k 개 고르기)
+// 전체 정렬 후 앞부분을 자르는 것과 비교하기 위한 것으로, 정렬 알고리즘과 따로 등록합니다.
+type Selector interface {
+	Name() string
+	DisplayName() string
+	Ordered() bool                  // true 면 k 개를 오름차순으로 돌려줌
+	Select(data []int, k int) []int // data 에서 가장 작은 k 개 (data 를 재배치할 수 있음)
+}
+
+// funcSelector 함수 하나로 구현한 Selector
+type funcSelector struct {
+	name, displayName string
+	ordered           bool
+	sel               func([]int, int) []int
+}
+
+func (s funcSelector) Name() string                   { return s.name }
+func (s funcSelector) DisplayName() string            { return s.displayName }
+func (s funcSelector) Ordered() bool                  { return s.ordered }
+func (s funcSelector) Select(data []int, k int) []int { return s.sel(data, k) }
+
+// NewSelector 함수로 Selector 생성
+func NewSelector(name, displayName string, ordered bool, fn func(data []int, k int) []int) Selector {
+	return funcSelector{name, displayName, ordered, fn}
+}
+
+// KeySorter 키 정렬 벤치마크에 등록하는 [][]byte / []string 정렬 알고리즘 (입력을 직접 정렬)
+type KeySorter interface {
+	Name() string
+	DisplayName() string
+	SortBytes(keys [][]byte)
+	SortStrings(keys []string)
+	// SupportsKeyType keyType ("bytes", "string") 키를 정렬할 수 있는지 여부
+	SupportsKeyType(keyType string) bool
+}
+
+// funcKeySorter 키 타입별 함수로 구현한 KeySorter
+type funcKeySorter struct {
+	name, displayName string
+	sortBytes         func([][]byte)
+	sortStrings       func([]string)
+}
+
+func (s funcKeySorter) Name() string              { return s.name }
+func (s funcKeySorter) DisplayName() string       { return s.displayName }
+func (s funcKeySorter) SortBytes(keys [][]byte)   { s.sortBytes(keys) }
+func (s funcKeySorter) SortStrings(keys []string) { s.sortStrings(keys) }
+
+func (s funcKeySorter) SupportsKeyType(keyType string) bool {
+	return keyType == "bytes" || (keyType == "string" && s.sortStrings != nil)
+}
+
+// NewKeySorter 키 타입별 정렬 함수로 KeySorter 생성
+// sortStrings 가 nil 이면 []byte 키만 지원합니다.
+func NewKeySorter(name, displayName string, sortBytes func([][]byte), sortStrings func([]string)) KeySorter {
+	return funcKeySorter{name, displayName, sortBytes, sortStrings}
+}
+
+// RecordSorter 레코드 정렬 벤치마크에 등록하는 (키, ID) 안정 정렬 알고리즘
+// 레코드는 키 슬라이스와 ID 슬라이스로 나눠(struct-of-arrays) 전달하며 둘을 함께 직접 정렬합니다.
+type RecordSorter interface {
+	Name() string
+	DisplayName() string
+	SortRecords(keys, ids []int)
+}
+
+// funcRecordSorter 함수 하나로 구현한 RecordSorter
+type funcRecordSorter struct {
+	name, displayName string
+	sort              func(keys, ids []int)
+}
+
+func (s funcRecordSorter) Name() string                { return s.name }
+func (s funcRecordSorter) DisplayName() string         { return s.displayName }
+func (s funcRecordSorter) SortRecords(keys, ids []int) { s.sort(keys, ids) }
+
+// NewRecordSorter 정렬 함수로 RecordSorter 생성
+func NewRecordSorter(name, displayName string, fn func(keys, ids []int)) RecordSorter {
+	return funcRecordSorter{name, displayName, fn}
+}
+
+// record 구조체 배열(array-of-structs) 레코드 정렬용 (키, ID) 쌍
+type record struct {
+	key, id int
+}
+
+// recordSorter 구조체 배열 정렬 함수를 RecordSorter 용 함수로 변환
+// 키/ID 슬라이스를 레코드 배열로 묶어 키로 정렬한 뒤 다시 나눕니다.
+func recordSorter(sortFunc func([]record, func(a, b record) int)) func(keys, ids []int) {
+	return func(keys, ids []int) {
+		recs := make([]record, len(keys))
+		for i := range recs {
+			recs[i] = record{keys[i], ids[i]}
+		}
+		sortFunc(recs, func(a, b record) int { return cmp.Compare(a.key, b.key) })
+		for i, r := range recs {
+			keys[i], ids[i] = r.key, r.id
+		}
+	}
+}
+
+// 등록된 정렬 알고리즘 (등록 순서 유지)
+var (
+	sorters     []Sorter
+	sorterIndex = make(map[string]Sorter)
+)
+
+// 등록된 선택 알고리즘 (등록 순서 유지)
+var (
+	selectors     []Selector
+	selectorIndex = make(map[string]Selector)
+)
+
+// 등록된 키 정렬 알고리즘 (등록 순서 유지)
+var (
+	keySorters     []KeySorter
+	keySorterIndex = make(map[string]KeySorter)
+)
+
+// 등록된 레코드 정렬 알고리즘 (등록 순서 유지)
+var (
+	recordSorters     []RecordSorter
+	recordSorterIndex = make(map[string]RecordSorter)
+)
+
+// Register 정렬 알고리즘 등록 (이름이 비었거나 중복되면 panic)
+func Register(s Sorter) {
+	checkName(s.Name())
+	sorters = append(sorters, s)
+	sorterIndex[s.Name()] = s
+}
+
+// RegisterSelector 선택 알고리즘 등록 (이름 규칙은 Register 와 같음)
+func RegisterSelector(s Selector) {
+	checkName(s.Name())
+	selectors = append(selectors, s)
+	selectorIndex[s.Name()] = s
+}
+
+// RegisterKeySorter 키 정렬 알고리즘 등록 (이름 규칙은 Register 와 같음)
+func RegisterKeySorter(s KeySorter) {
+	checkName(s.Name())
+	keySorters = append(keySorters, s)
+	keySorterIndex[s.Name()] = s
+}
+
+// RegisterRecordSorter 레코드 정렬 알고리즘 등록 (이름 규칙은 Register 와 같음)
+func RegisterRecordSorter(s RecordSorter) {
+	checkName(s.Name())
+	recordSorters = append(recordSorters, s)
+	recordSorterIndex[s.Name()] = s
+}
+
+// checkName 정렬/선택/키 정렬/레코드 정렬 알고리즘 이름은 결과 파일에서 한 공간을 공유하므로 서로 겹칠 수 없습니다.
+func checkName(name string) {
+	if name == "" || name == "all" || name == externalSortName {
+		panic(fmt.Sprintf("sort: 사용할 수 없는 알고리즘 이름 %q", name))
+	}
+	_, dupSorter := sorterIndex[name]
+	_, dupSelector := selectorIndex[name]
+	_, dupKeySorter := keySorterIndex[name]
+	_, dupRecordSorter := recordSorterIndex[name]
+	if dupSorter || dupSelector || dupKeySorter || dupRecordSorter {
+		panic(fmt.Sprintf("sort: 알고리즘 %q 가 이미 등록되어 있습니다", name))
+	}
+}
+
+// Sorters 등록된 정렬 알고리즘 목록 (등록 순서)
+func Sorters() []Sorter {
+	return slices.Clone(sorters)
+}
+
+// Selectors 등록된 선택 알고리즘 목록 (등록 순서)
+func Selectors() []Selector {
+	return slices.Clone(selectors)
+}
+
+// KeySorters 등록된 키 정렬 알고리즘 목록 (등록 순서)
+func KeySorters() []KeySorter {
+	return slices.Clone(keySorters)
+}
+
+// RecordSorters 등록된 레코드 정렬 알고리즘 목록 (등록 순서)
+func RecordSorters() []RecordSorter {
+	return slices.Clone(recordSorters)
+}
+
+// lookupSelector 이름으로 등록된 선택 알고리즘 찾기
+func lookupSelector(name string) (Selector, bool) {
+	s, ok := selectorIndex[name]
+	return s, ok
+}
+
+// lookupSorter 이름으로 등록된 정렬 알고리즘 찾기
+func lookupSorter(name string) (Sorter, bool) {
+	s, ok := sorterIndex[name]
+	return s, ok
+}
+
+// lookupKeySorter 이름으로 등록된 키 정렬 알고리즘 찾기
+func lookupKeySorter(name string) (KeySorter, bool) {
+	s, ok := keySorterIndex[name]
+	return s, ok
+}
+
+// lookupRecordSorter 이름으로 등록된 레코드 정렬 알고리즘 찾기
+func lookupRecordSorter(name string) (RecordSorter, bool) {
+	s, ok := recordSorterIndex[name]
+	return s, ok
+}
+
+// externalSortName 외부 정렬은 []int 가 아니라 파일을 정렬하므로 레지스트리와 별도로 처리합니다.
+const (
+	externalSortName        = "external_sort"
+	externalSortDisplayName = "외부정렬"
+)
+
+// algorithmDisplayName 결과의 알고리즘 이름을 출력용 이름으로 변환
+func algorithmDisplayName(name string) string {
+	if name == externalSortName {
+		return externalSortDisplayName
+	}
+	if s, ok := lookupSorter(name); ok {
+		return s.DisplayName()
+	}
+	if s, ok := lookupSelector(name); ok {
+		return s.DisplayName()
+	}
+	if s, ok := lookupKeySorter(name); ok {
+		return s.DisplayName()
+	}
+	if s, ok := lookupRecordSorter(name); ok {
+		return s.DisplayName()
+	}
+	return name
+}
+
+// knownAlgorithm 행렬에서 사용할 수 있는 알고리즘 이름인지
+func knownAlgorithm(name string) bool {
+	_, ok := lookupSorter(name)
+	return ok || name == externalSortName
+}
+
+// isParallelAlgorithm 병렬 알고리즘인지 (등록 이름이 parallel_ 로 시작하는 규칙을 따름)
+func isParallelAlgorithm(name string) bool {
+	return strings.HasPrefix(name, "parallel_")
+}
+
+// sampleSortOptions 병렬 샘플 정렬 설정 (행렬의 sample_oversampling 으로 조정)
+var sampleSortOptions sorts.SampleSortOptions
+
+// 기본 제공 알고리즘과 표준 라이브러리 기준선
+func init() {
+	Register(InPlaceSorter("quicksort", "퀵소트", false, sorts.QuickSort[int]))
+	Register(InPlaceSorter("parallel_quicksort", "병렬퀵소트", false, sorts.ParallelQuickSort[int]))
+	Register(InPlaceSorter("introsort", "인트로소트", false, sorts.IntroSort[int]))
+	Register(InPlaceSorter("parallel_introsort", "병렬인트로소트", false, sorts.ParallelIntroSort[int]))
+	Register(InPlaceSorter("pdqsort", "패턴회피퀵소트", false, sorts.PdqSort[int]))
+	Register(InPlaceSorter("parallel_pdqsort", "병렬패턴회피퀵소트", false, sorts.ParallelPdqSort[int]))
+	Register(CopySorter("mergesort", "머지소트", true, sorts.MergeSort[int]))
+	Register(CopySorter("parallel_mergesort", "병렬머지소트", true, sorts.ParallelMergeSort[int]))
+	Register(InPlaceSorter("mergesort_buffered", "버퍼머지소트", true, sorts.MergeSortBuffered[int]))
+	Register(InPlaceSorter("parallel_mergesort_buffered", "병렬버퍼머지소트", true, sorts.ParallelMergeSortBuffered[int]))
+	Register(InPlaceSorter("radixsort", "기수정렬", true, sorts.RadixSort[int]))
+	Register(InPlaceSorter("parallel_radixsort", "병렬기수정렬", true, sorts.ParallelRadixSort[int]))
+	Register(InPlaceSorter("natural_mergesort", "자연병합정렬", true, sorts.NaturalMergeSort[int]))
+	Register(InPlaceSorter("parallel_natural_mergesort", "병렬자연병합정렬", true, sorts.ParallelNaturalMergeSort[int]))
+	Register(InPlaceSorter("stable_sort", "안정정렬", true, func(data []int) {
+		sorts.StableSortFunc(data, cmp.Compare[int])
+	}))
+	Register(InPlaceSorter("parallel_stable_sort", "병렬안정정렬", true, func(data []int) {
+		sorts.ParallelStableSortFunc(data, cmp.Compare[int])
+	}))
+	Register(InPlaceSorter("parallel_samplesort", "병렬샘플정렬", false, func(data []int) {
+		sorts.ParallelSampleSortWith(data, sampleSortOptions)
+	}))
+
+	// 표준 라이브러리 기준선
+	Register(InPlaceSorter("std_slices_sort", "slices.Sort", false, slices.Sort[[]int]))
+	Register(InPlaceSorter("std_sort_ints", "sort.Ints", false, sort.Ints))
+	Register(InPlaceSorter("std_slices_sortstable", "slices.SortStableFunc", true, func(data []int) {
+		slices.SortStableFunc(data, cmp.Compare[int])
+	}))
+
+	// 선택 알고리즘과 기준선 (전체 정렬 후 자르기)
+	RegisterSelector(NewSelector("select", "퀵셀렉트", false, func(data []int, k int) []int {
+		sorts.Select(data, k-1)
+		return data[:k]
+	}))
+	RegisterSelector(NewSelector("parallel_nth_element", "병렬NthElement", false, func(data []int, k int) []int {
+		sorts.ParallelNthElement(data, k-1)
+		return data[:k]
+	}))
+	RegisterSelector(NewSelector("partial_sort", "부분정렬", true, func(data []int, k int) []int {
+		sorts.PartialSort(data, k)
+		return data[:k]
+	}))
+	RegisterSelector(NewSelector("topk_heap", "TopK 힙", true, func(data []int, k int) []int {
+		// TopKFunc 는 큰 순서이므로 비교를 뒤집어 가장 작은 k 개를 오름차순으로 받음
+		return sorts.TopKFunc(slices.Values(data), k, func(a, b int) int { return cmp.Compare(b, a) })
+	}))
+	RegisterSelector(NewSelector("sort_then_slice", "전체정렬+슬라이스", true, func(data []int, k int) []int {
+		slices.Sort(data)
+		return data[:k]
+	}))
+
+	// 키 정렬 알고리즘과 기준선
+	RegisterKeySorter(NewKeySorter("multikey_quicksort", "멀티키퀵소트",
+		sorts.MultikeyQuickSort[[]byte], sorts.MultikeyQuickSort[string]))
+	RegisterKeySorter(NewKeySorter("parallel_multikey_quicksort", "병렬멀티키퀵소트",
+		sorts.ParallelMultikeyQuickSort[[]byte], sorts.ParallelMultikeyQuickSort[string]))
+	RegisterKeySorter(NewKeySorter("msd_radixsort", "MSD기수정렬",
+		sorts.MSDRadixSort[[]byte], sorts.MSDRadixSort[string]))
+	RegisterKeySorter(NewKeySorter("parallel_msd_radixsort", "병렬MSD기수정렬",
+		sorts.ParallelMSDRadixSort[[]byte], sorts.ParallelMSDRadixSort[string]))
+	// 고정 폭 LSD 기수정렬은 keySize 바이트 키만 정렬 (string 키 미지원)
+	RegisterKeySorter(NewKeySorter("radixsort_bytes", "바이트기수정렬",
+		func(keys [][]byte) { sorts.RadixSortBytes(keys, keySize) }, nil))
+	RegisterKeySorter(NewKeySorter("parallel_radixsort_bytes", "병렬바이트기수정렬",
+		func(keys [][]byte) { sorts.ParallelRadixSortBytes(keys, keySize) }, nil))
+	RegisterKeySorter(NewKeySorter("std_slices_sort_keys", "slices.Sort (키)",
+		func(keys [][]byte) { slices.SortFunc(keys, bytes.Compare) }, slices.Sort[[]string]))
+
+	// 레코드 안정 정렬 (키/ID 슬라이스, 레코드 배열) 과 기준선
+	RegisterRecordSorter(NewRecordSorter("sort_by_key", "SortByKey", sorts.SortByKey[int, int]))
+	RegisterRecordSorter(NewRecordSorter("parallel_sort_by_key", "병렬SortByKey", sorts.ParallelSortByKey[int, int]))
+	RegisterRecordSorter(NewRecordSorter("stable_sort_records", "안정정렬 (레코드 배열)",
+		recordSorter(sorts.StableSortFunc[record])))
+	RegisterRecordSorter(NewRecordSorter("parallel_stable_sort_records", "병렬안정정렬 (레코드 배열)",
+		recordSorter(sorts.ParallelStableSortFunc[record])))
+	RegisterRecordSorter(NewRecordSorter("std_slices_sortstable_records", "slices.SortStableFunc (레코드 배열)",
+		recordSorter(slices.SortStableFunc[[]record])))
+}
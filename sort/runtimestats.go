@@ -0,0 +1,114 @@
+package main
+
+import (
+	"math"
+	"runtime/metrics"
+	"sync"
+	"time"
+)
+
+// runtime/metrics 에서 읽는 항목
+const (
+	metricGCCycles  = "/gc/cycles/total:gc-cycles"
+	metricGCPauses  = "/sched/pauses/total/gc:seconds"
+	metricHeapInUse = "/memory/classes/heap/objects:bytes"
+)
+
+// 최대 힙 사용량 샘플링 주기
+const heapSampleInterval = time.Millisecond
+
+// gcSnapshot GC 관련 누적 지표 스냅샷
+type gcSnapshot struct {
+	cycles uint64
+	pauses *metrics.Float64Histogram
+}
+
+// readGCSnapshot 현재 GC 횟수와 GC 정지 시간 히스토그램 읽기
+func readGCSnapshot() gcSnapshot {
+	samples := []metrics.Sample{{Name: metricGCCycles}, {Name: metricGCPauses}}
+	metrics.Read(samples)
+
+	var snap gcSnapshot
+	if samples[0].Value.Kind() == metrics.KindUint64 {
+		snap.cycles = samples[0].Value.Uint64()
+	}
+	if samples[1].Value.Kind() == metrics.KindFloat64Histogram {
+		snap.pauses = samples[1].Value.Float64Histogram()
+	}
+	return snap
+}
+
+// gcPauseDelta 두 히스토그램 사이의 GC 정지 시간 합 (버킷 중앙값으로 근사)
+func gcPauseDelta(start, end *metrics.Float64Histogram) time.Duration {
+	if start == nil || end == nil || len(start.Counts) != len(end.Counts) {
+		return 0
+	}
+
+	var total float64
+	for i := range end.Counts {
+		n := end.Counts[i] - start.Counts[i]
+		if n == 0 {
+			continue
+		}
+		lo, hi := end.Buckets[i], end.Buckets[i+1]
+		// 양 끝의 무한대 버킷은 유한한 경계값으로 대체
+		if math.IsInf(lo, -1) {
+			lo = 0
+		}
+		if math.IsInf(hi, 1) {
+			hi = lo
+		}
+		total += float64(n) * (lo + hi) / 2
+	}
+	return time.Duration(total * float64(time.Second))
+}
+
+// heapSampler 측정 구간 동안 힙 사용량 최댓값을 주기적으로 기록
+// 샘플링 자체가 메모리 사용량 측정에 잡히지 않도록 샘플 슬라이스를 미리 할당해 재사용합니다.
+type heapSampler struct {
+	stop   chan struct{}
+	wg     sync.WaitGroup
+	sample []metrics.Sample
+	peak   uint64
+}
+
+func startHeapSampler() *heapSampler {
+	h := &heapSampler{
+		stop:   make(chan struct{}),
+		sample: []metrics.Sample{{Name: metricHeapInUse}},
+	}
+	h.peak = h.read()
+	h.wg.Add(1)
+
+	go func() {
+		defer h.wg.Done()
+		ticker := time.NewTicker(heapSampleInterval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-h.stop:
+				return
+			case <-ticker.C:
+				h.peak = max(h.peak, h.read())
+			}
+		}
+	}()
+	return h
+}
+
+// finish 샘플링을 멈추고 마지막 값까지 반영한 최댓값 반환
+func (h *heapSampler) finish() uint64 {
+	close(h.stop)
+	h.wg.Wait()
+	return max(h.peak, h.read())
+}
+
+// read 현재 힙 객체가 차지하는 바이트 수
+func (h *heapSampler) read() uint64 {
+	metrics.Read(h.sample)
+	if h.sample[0].Value.Kind() != metrics.KindUint64 {
+		return 0
+	}
+	return h.sample[0].Value.Uint64()
+}
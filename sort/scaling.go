@@ -0,0 +1,237 @@
+package main
+
+import (
+	"fmt"
+	"math"
+	"runtime"
+	"slices"
+	"strings"
+	"time"
+)
+
+// GOMAXPROCS 확장성 분석
+// 같은 조건을 여러 GOMAXPROCS 에서 측정한 병렬 알고리즘의 중앙값 실행시간으로
+// 가속(speedup), 병렬 효율, Amdahl 직렬 비율을 계산합니다.
+
+// ScalingPoint 한 GOMAXPROCS 에서의 측정
+type ScalingPoint struct {
+	GOMAXPROCS int           `json:"gomaxprocs"`
+	Median     time.Duration `json:"median"`
+	Speedup    float64       `json:"speedup"`    // 기준 GOMAXPROCS 의 중앙값 / 이 GOMAXPROCS 의 중앙값
+	Efficiency float64       `json:"efficiency"` // 가속 / (GOMAXPROCS / 기준 GOMAXPROCS)
+}
+
+// ScalingStats 같은 조건(알고리즘, 크기, 저장방식, 분포, 선택 k, 키 타입, 레코드 여부)의 GOMAXPROCS 별 확장성
+type ScalingStats struct {
+	Algorithm    string `json:"algorithm"`
+	DataSize     int    `json:"data_size"`
+	StorageType  string `json:"storage_type"`
+	Distribution string `json:"distribution"`
+	SelectK      int    `json:"select_k,omitempty"`
+	KeyType      string `json:"key_type,omitempty"`
+	Records      bool   `json:"records,omitempty"`
+
+	BaseProcs int            `json:"base_gomaxprocs"` // 가속의 기준 (측정한 가장 작은 GOMAXPROCS)
+	Points    []ScalingPoint `json:"points"`
+
+	// Amdahl 법칙 T(p) = T1·(f + (1-f)/p) 에 맞춘 직렬 비율 f 와 가속 상한 1/f
+	// (맞출 수 없으면 생략)
+	SerialFraction *float64 `json:"amdahl_serial_fraction,omitempty"`
+	MaxSpeedup     *float64 `json:"amdahl_max_speedup,omitempty"`
+}
+
+// sweepProcs 확장성 측정에 쓰는 GOMAXPROCS 값 (1, 2, 4, … 와 마지막으로 maxProcs)
+func sweepProcs(maxProcs int) []int {
+	var procs []int
+	for p := 1; p < maxProcs; p *= 2 {
+		procs = append(procs, p)
+	}
+	return append(procs, max(1, maxProcs))
+}
+
+// applySweep -sweep 모드: 모든 스위트를 병렬 알고리즘만 남기고 sweepProcs(NumCPU) 마다 실행
+// 병렬 알고리즘이 하나도 없는 스위트는 제외합니다.
+func (m *benchmarkMatrix) applySweep() error {
+	m.GOMAXPROCS = sweepProcs(runtime.NumCPU())
+
+	suites := m.Suites[:0]
+	for _, s := range m.Suites {
+		s.Algorithms = slices.DeleteFunc(s.algorithms(), func(algo string) bool { return !isParallelAlgorithm(algo) })
+		if len(s.Algorithms) > 0 {
+			suites = append(suites, s)
+		}
+	}
+	if len(suites) == 0 {
+		return fmt.Errorf("확장성 측정: 행렬에 병렬 알고리즘이 없습니다")
+	}
+	m.Suites = suites
+	return nil
+}
+
+// scalingKey 확장성 그룹 키 (GOMAXPROCS 를 뺀 summaryKey)
+func scalingKey(k summaryKey) summaryKey {
+	k.procs = 0
+	return k
+}
+
+// computeScaling 병렬 알고리즘 중 둘 이상의 GOMAXPROCS 에서 측정한 조건의 확장성 (처음 나타난 순서 유지)
+func computeScaling(summaries []SummaryStats) []ScalingStats {
+	var order []summaryKey
+	groups := make(map[summaryKey][]SummaryStats)
+	for _, s := range summaries {
+		if !isParallelAlgorithm(s.Algorithm) || s.Median <= 0 {
+			continue
+		}
+		k := scalingKey(s.key())
+		if _, ok := groups[k]; !ok {
+			order = append(order, k)
+		}
+		groups[k] = append(groups[k], s)
+	}
+
+	var scaling []ScalingStats
+	for _, k := range order {
+		group := groups[k]
+		if len(group) < 2 {
+			continue
+		}
+		scaling = append(scaling, scalingOf(group))
+	}
+	return scaling
+}
+
+// scalingOf 한 조건의 GOMAXPROCS 별 요약으로 확장성 계산
+func scalingOf(group []SummaryStats) ScalingStats {
+	slices.SortFunc(group, func(a, b SummaryStats) int { return a.GOMAXPROCS - b.GOMAXPROCS })
+	base := group[0]
+	st := ScalingStats{
+		Algorithm:    base.Algorithm,
+		DataSize:     base.DataSize,
+		StorageType:  base.StorageType,
+		Distribution: base.Distribution,
+		SelectK:      base.SelectK,
+		KeyType:      base.KeyType,
+		Records:      base.Records,
+		BaseProcs:    base.GOMAXPROCS,
+	}
+
+	for _, s := range group {
+		speedup := float64(base.Median) / float64(s.Median)
+		st.Points = append(st.Points, ScalingPoint{
+			GOMAXPROCS: s.GOMAXPROCS,
+			Median:     s.Median,
+			Speedup:    speedup,
+			Efficiency: speedup * float64(base.GOMAXPROCS) / float64(s.GOMAXPROCS),
+		})
+	}
+
+	if f, ok := fitAmdahl(st.Points); ok {
+		st.SerialFraction = &f
+		if f > 0 {
+			limit := 1 / f
+			st.MaxSpeedup = &limit
+		}
+	}
+	return st
+}
+
+// fitAmdahl T(p) = a + b/p 를 최소제곱으로 맞춰 직렬 비율 f = a / (a + b) 를 구함
+// 직렬 시간 a 는 p 와 무관하고 병렬 시간 b 는 p 에 반비례한다는 Amdahl 모형이며,
+// a, b 가 음수로 나오면 (측정 잡음) 0 으로 잘라 f 를 [0, 1] 에 둡니다.
+// 서로 다른 GOMAXPROCS 가 둘 미만이면 맞출 수 없습니다.
+func fitAmdahl(points []ScalingPoint) (float64, bool) {
+	n := float64(len(points))
+	var sx, sy, sxx, sxy float64
+	for _, pt := range points {
+		x, y := 1/float64(pt.GOMAXPROCS), float64(pt.Median)
+		sx += x
+		sy += y
+		sxx += x * x
+		sxy += x * y
+	}
+	det := n*sxx - sx*sx
+	if len(points) < 2 || det <= 1e-12 {
+		return 0, false
+	}
+
+	b := (n*sxy - sx*sy) / det
+	a := (sy - b*sx) / n
+	a, b = max(a, 0), max(b, 0)
+	if a+b == 0 {
+		return 0, false
+	}
+	return a / (a + b), true
+}
+
+// writeScalingMarkdown 확장성 표 (조건마다 알고리즘 × GOMAXPROCS)
+// 칸은 중앙값, 가속, 병렬 효율이고 마지막 두 열은 Amdahl 직렬 비율과 가속 상한입니다.
+func writeScalingMarkdown(builder *strings.Builder, scaling []ScalingStats) {
+	if len(scaling) == 0 {
+		return
+	}
+	builder.WriteString("## GOMAXPROCS 확장성\n\n")
+	builder.WriteString("가속 = 가장 작은 GOMAXPROCS 의 중앙값 실행시간 / 해당 GOMAXPROCS 의 중앙값, ")
+	builder.WriteString("효율 = 가속 / GOMAXPROCS 배수, 직렬 비율 f 는 T(p) = T1·(f + (1-f)/p) 에 맞춘 값입니다.\n\n")
+
+	type section struct {
+		key   summaryKey
+		procs []int
+		rows  []ScalingStats
+	}
+	var sections []*section
+	index := make(map[summaryKey]*section)
+	for _, st := range scaling {
+		k := summaryKey{"", 0, st.DataSize, st.StorageType, st.Distribution, st.SelectK, st.KeyType, st.Records}
+		sec, ok := index[k]
+		if !ok {
+			sec = &section{key: k}
+			index[k] = sec
+			sections = append(sections, sec)
+		}
+		sec.rows = append(sec.rows, st)
+		for _, pt := range st.Points {
+			if !slices.Contains(sec.procs, pt.GOMAXPROCS) {
+				sec.procs = append(sec.procs, pt.GOMAXPROCS)
+			}
+		}
+	}
+
+	for _, sec := range sections {
+		slices.Sort(sec.procs)
+		rs := reportSection{storage: sec.key.storage, size: sec.key.size, k: sec.key.k, keyType: sec.key.keyType, records: sec.key.records}
+		builder.WriteString(fmt.Sprintf("### %s - %s 분포\n\n", rs.title(false), displayName(distributionNames, sec.key.distribution)))
+
+		builder.WriteString("| 알고리즘 |")
+		for _, p := range sec.procs {
+			builder.WriteString(fmt.Sprintf(" GOMAXPROCS=%d |", p))
+		}
+		builder.WriteString(" 직렬 비율 | 가속 상한 |\n|----------|")
+		for range sec.procs {
+			builder.WriteString("------|")
+		}
+		builder.WriteString("-----------|-----------|\n")
+
+		for _, st := range sec.rows {
+			builder.WriteString(fmt.Sprintf("| %s |", algorithmDisplayName(st.Algorithm)))
+			for _, p := range sec.procs {
+				i := slices.IndexFunc(st.Points, func(pt ScalingPoint) bool { return pt.GOMAXPROCS == p })
+				if i < 0 {
+					builder.WriteString(" - |")
+					continue
+				}
+				pt := st.Points[i]
+				builder.WriteString(fmt.Sprintf(" %v ×%.2f (%.0f%%) |", pt.Median, pt.Speedup, pt.Efficiency*100))
+			}
+			builder.WriteString(fmt.Sprintf(" %s | %s |\n", optionalFloat(st.SerialFraction, "%.3f"), optionalFloat(st.MaxSpeedup, "×%.1f")))
+		}
+		builder.WriteString("\n")
+	}
+}
+
+// optionalFloat 값이 없거나 유한하지 않으면 -
+func optionalFloat(v *float64, format string) string {
+	if v == nil || math.IsInf(*v, 0) || math.IsNaN(*v) {
+		return "-"
+	}
+	return fmt.Sprintf(format, *v)
+}
@@ -0,0 +1,95 @@
+package main
+
+import (
+	"math"
+	"slices"
+	"testing"
+	"time"
+)
+
+// amdahlPoints T(p) = a + b/p 를 따르는 측정 (a, b 는 밀리초)
+func amdahlPoints(a, b float64, procs ...int) []ScalingPoint {
+	points := make([]ScalingPoint, len(procs))
+	for i, p := range procs {
+		points[i] = ScalingPoint{GOMAXPROCS: p, Median: time.Duration((a + b/float64(p)) * float64(time.Millisecond))}
+	}
+	return points
+}
+
+func TestFitAmdahl(t *testing.T) {
+	for _, tc := range []struct {
+		name   string
+		points []ScalingPoint
+		want   float64
+		ok     bool
+	}{
+		{"직렬 20%", amdahlPoints(20, 80, 1, 2, 4, 8), 0.2, true},
+		{"직렬 5%, 두 점", amdahlPoints(5, 95, 1, 6), 0.05, true},
+		{"완전 병렬", amdahlPoints(0, 100, 1, 2, 4), 0, true},
+		{"완전 직렬", amdahlPoints(100, 0, 1, 2, 4), 1, true},
+		// 초선형 가속은 절편이 음수로 맞춰지므로 a 를 0 으로 잘라 f = 0
+		{"음수 절편", []ScalingPoint{
+			{GOMAXPROCS: 1, Median: 100 * time.Millisecond},
+			{GOMAXPROCS: 2, Median: 40 * time.Millisecond},
+			{GOMAXPROCS: 4, Median: 10 * time.Millisecond},
+		}, 0, true},
+		// 코어가 늘수록 느려지면 기울기가 음수이므로 b 를 0 으로 잘라 f = 1
+		{"음수 기울기", []ScalingPoint{
+			{GOMAXPROCS: 1, Median: 10 * time.Millisecond},
+			{GOMAXPROCS: 2, Median: 20 * time.Millisecond},
+		}, 1, true},
+		{"한 점", amdahlPoints(20, 80, 4), 0, false},
+		{"같은 GOMAXPROCS", amdahlPoints(20, 80, 2, 2), 0, false},
+	} {
+		got, ok := fitAmdahl(tc.points)
+		if ok != tc.ok || math.Abs(got-tc.want) > 1e-6 {
+			t.Errorf("%s: f=%v ok=%v, 기대 f=%v ok=%v", tc.name, got, ok, tc.want, tc.ok)
+		}
+	}
+}
+
+func TestSweepProcs(t *testing.T) {
+	for _, tc := range []struct {
+		maxProcs int
+		want     []int
+	}{
+		{0, []int{1}},
+		{1, []int{1}},
+		{2, []int{1, 2}},
+		{6, []int{1, 2, 4, 6}},
+		{8, []int{1, 2, 4, 8}},
+	} {
+		if got := sweepProcs(tc.maxProcs); !slices.Equal(got, tc.want) {
+			t.Errorf("sweepProcs(%d) = %v, 기대 %v", tc.maxProcs, got, tc.want)
+		}
+	}
+}
+
+func TestComputeScaling(t *testing.T) {
+	var summaries []SummaryStats
+	for _, pt := range amdahlPoints(20, 80, 4, 1, 2) {
+		for _, algo := range []string{"parallel_quicksort", "quicksort"} {
+			summaries = append(summaries, SummaryStats{Algorithm: algo, DataSize: 1000, GOMAXPROCS: pt.GOMAXPROCS, Median: pt.Median})
+		}
+	}
+	// GOMAXPROCS 하나에서만 측정한 조건은 제외
+	summaries = append(summaries, SummaryStats{Algorithm: "parallel_mergesort", DataSize: 1000, GOMAXPROCS: 1, Median: time.Millisecond})
+
+	scaling := computeScaling(summaries)
+	if len(scaling) != 1 || scaling[0].Algorithm != "parallel_quicksort" {
+		t.Fatalf("병렬 알고리즘 한 조건만 나와야 함: %+v", scaling)
+	}
+
+	st := scaling[0]
+	if st.BaseProcs != 1 || len(st.Points) != 3 {
+		t.Fatalf("기준 GOMAXPROCS %d, 점 %d개", st.BaseProcs, len(st.Points))
+	}
+	last := st.Points[2] // GOMAXPROCS 순으로 정렬됨: 4 에서 T = 40ms
+	if last.GOMAXPROCS != 4 || math.Abs(last.Speedup-2.5) > 1e-9 || math.Abs(last.Efficiency-0.625) > 1e-9 {
+		t.Errorf("GOMAXPROCS=4: %+v, 기대 가속 2.5 효율 0.625", last)
+	}
+	if st.SerialFraction == nil || math.Abs(*st.SerialFraction-0.2) > 1e-6 ||
+		st.MaxSpeedup == nil || math.Abs(*st.MaxSpeedup-5) > 1e-4 {
+		t.Errorf("Amdahl: f=%v 상한=%v, 기대 0.2, 5", optionalFloat(st.SerialFraction, "%v"), optionalFloat(st.MaxSpeedup, "%v"))
+	}
+}
@@ -0,0 +1,292 @@
+package sorts
+
+import (
+	"bufio"
+	"container/heap"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+)
+
+// ExternalSortOptions 외부 정렬 설정
+type ExternalSortOptions struct {
+	// 청크 정렬에 사용할 메모리 예산 (바이트)
+	MemoryBudget int64
+	// 정렬된 런(run) 파일을 저장할 임시 디렉토리 (비어 있으면 os.TempDir)
+	TempDir string
+	// 한 번에 병합할 최대 런 수 (0 이면 기본값 64)
+	// 병합 버퍼가 메모리 예산 안에 들어가도록 더 적게 병합할 수 있습니다 (최소 2).
+	MaxFanIn int
+}
+
+// ExternalSortStats 외부 정렬 결과 통계
+type ExternalSortStats struct {
+	Elements    int // 정렬한 전체 원소 수
+	Runs        int // 디스크로 내보낸 초기 런 수
+	MergePasses int // 수행한 병합 패스 수
+}
+
+const (
+	defaultMaxFanIn  = 64
+	minMergeBufBytes = 4 * 1024
+	intBytes         = 8
+)
+
+// ExternalSort 메모리보다 큰 정수 파일을 정렬합니다.
+// 입력은 writeDataToFile 과 같은 "한 줄에 정수 하나" 텍스트 형식이며,
+// MemoryBudget 크기의 청크 단위로 읽어 ParallelQuickSort 로 정렬한 뒤
+// 임시 런 파일로 내보내고, k-way 병합으로 outputPath 에 같은 형식으로 씁니다.
+func ExternalSort(inputPath, outputPath string, opts ExternalSortOptions) (ExternalSortStats, error) {
+	var stats ExternalSortStats
+
+	chunkLen := int(opts.MemoryBudget / intBytes)
+	if chunkLen < 1 {
+		return stats, fmt.Errorf("메모리 예산이 너무 작습니다: %d bytes", opts.MemoryBudget)
+	}
+	fanIn := opts.MaxFanIn
+	if fanIn <= 0 {
+		fanIn = defaultMaxFanIn
+	}
+	// 병합은 런마다 읽기 버퍼 하나와 출력 버퍼 하나를 쓰므로 예산을 fanIn+1 개로 나눕니다.
+	// 버퍼가 minMergeBufBytes 보다 작아지면 한 번에 병합하는 런 수를 줄입니다.
+	fanIn = max(2, min(fanIn, int(opts.MemoryBudget/minMergeBufBytes)-1))
+	bufSize := int(opts.MemoryBudget) / (fanIn + 1)
+
+	in, err := os.Open(inputPath)
+	if err != nil {
+		return stats, err
+	}
+	defer in.Close()
+
+	tempDir, err := os.MkdirTemp(opts.TempDir, "extsort-*")
+	if err != nil {
+		return stats, fmt.Errorf("임시 디렉토리 생성 실패: %w", err)
+	}
+	defer os.RemoveAll(tempDir)
+
+	// 1단계: 청크 단위로 읽고 정렬해서 런 파일로 내보내기
+	chunk := make([]int, 0, chunkLen)
+	var runs []string
+
+	spill := func() error {
+		ParallelQuickSort(chunk)
+		name := filepath.Join(tempDir, fmt.Sprintf("run-%06d.bin", len(runs)))
+		if err := writeRun(name, chunk); err != nil {
+			return err
+		}
+		runs = append(runs, name)
+		chunk = chunk[:0]
+		return nil
+	}
+
+	scanner := bufio.NewScanner(in)
+	scanner.Buffer(make([]byte, 64*1024), bufio.MaxScanTokenSize)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" {
+			continue
+		}
+		num, err := strconv.Atoi(line)
+		if err != nil {
+			return stats, err
+		}
+		chunk = append(chunk, num)
+		stats.Elements++
+
+		if len(chunk) == chunkLen {
+			if err := spill(); err != nil {
+				return stats, err
+			}
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return stats, err
+	}
+
+	// 전체 데이터가 한 청크에 들어가면 디스크를 거치지 않고 바로 출력
+	if len(runs) == 0 {
+		ParallelQuickSort(chunk)
+		return stats, writeText(outputPath, chunk)
+	}
+	if len(chunk) > 0 {
+		if err := spill(); err != nil {
+			return stats, err
+		}
+	}
+	chunk = nil
+	stats.Runs = len(runs)
+
+	// 2단계: 런 수가 fanIn 이하가 될 때까지 중간 병합
+	for pass := 0; len(runs) > fanIn; pass++ {
+		var next []string
+		for i := 0; i < len(runs); i += fanIn {
+			group := runs[i:min(i+fanIn, len(runs))]
+			name := filepath.Join(tempDir, fmt.Sprintf("pass-%d-%06d.bin", pass, len(next)))
+			if err := mergeRuns(group, name, bufSize, false); err != nil {
+				return stats, err
+			}
+			for _, r := range group {
+				os.Remove(r)
+			}
+			next = append(next, name)
+		}
+		runs = next
+		stats.MergePasses++
+	}
+
+	// 3단계: 최종 k-way 병합 후 텍스트로 출력
+	stats.MergePasses++
+	return stats, mergeRuns(runs, outputPath, bufSize, true)
+}
+
+// writeRun 정렬된 청크를 리틀엔디언 바이너리 런 파일로 저장
+func writeRun(name string, data []int) error {
+	file, err := os.Create(name)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	writer := bufio.NewWriterSize(file, 64*1024)
+	var buf [intBytes]byte
+	for _, v := range data {
+		binary.LittleEndian.PutUint64(buf[:], uint64(v))
+		if _, err := writer.Write(buf[:]); err != nil {
+			return err
+		}
+	}
+	if err := writer.Flush(); err != nil {
+		return err
+	}
+	return file.Close()
+}
+
+// writeText 정렬된 데이터를 한 줄에 하나씩 텍스트로 저장
+func writeText(name string, data []int) error {
+	file, err := os.Create(name)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	writer := bufio.NewWriterSize(file, 64*1024)
+	buf := make([]byte, 0, 24)
+	for _, v := range data {
+		buf = strconv.AppendInt(buf[:0], int64(v), 10)
+		buf = append(buf, '\n')
+		if _, err := writer.Write(buf); err != nil {
+			return err
+		}
+	}
+	if err := writer.Flush(); err != nil {
+		return err
+	}
+	return file.Close()
+}
+
+// runReader 런 파일을 순차적으로 읽는 커서
+type runReader struct {
+	file   *os.File
+	reader *bufio.Reader
+	value  int
+}
+
+func (r *runReader) next() (bool, error) {
+	var buf [intBytes]byte
+	if _, err := io.ReadFull(r.reader, buf[:]); err != nil {
+		if errors.Is(err, io.EOF) {
+			return false, nil
+		}
+		return false, err
+	}
+	r.value = int(binary.LittleEndian.Uint64(buf[:]))
+	return true, nil
+}
+
+// runHeap k-way 병합용 최소 힙
+type runHeap []*runReader
+
+func (h runHeap) Len() int           { return len(h) }
+func (h runHeap) Less(i, j int) bool { return h[i].value < h[j].value }
+func (h runHeap) Swap(i, j int)      { h[i], h[j] = h[j], h[i] }
+func (h *runHeap) Push(x any)        { *h = append(*h, x.(*runReader)) }
+func (h *runHeap) Pop() any {
+	old := *h
+	n := len(old)
+	x := old[n-1]
+	*h = old[:n-1]
+	return x
+}
+
+// mergeRuns 여러 런 파일을 하나로 병합 (asText 이면 텍스트, 아니면 바이너리 런으로 출력)
+// 입력 런마다와 출력에 각각 bufSize 바이트 버퍼를 씁니다.
+func mergeRuns(runs []string, outputPath string, bufSize int, asText bool) error {
+	h := make(runHeap, 0, len(runs))
+	defer func() {
+		for _, r := range h {
+			r.file.Close()
+		}
+	}()
+
+	for _, name := range runs {
+		file, err := os.Open(name)
+		if err != nil {
+			return err
+		}
+		r := &runReader{file: file, reader: bufio.NewReaderSize(file, bufSize)}
+		ok, err := r.next()
+		if err != nil {
+			file.Close()
+			return err
+		}
+		if !ok {
+			file.Close()
+			continue
+		}
+		h = append(h, r)
+	}
+	heap.Init(&h)
+
+	out, err := os.Create(outputPath)
+	if err != nil {
+		return err
+	}
+	defer out.Close()
+
+	writer := bufio.NewWriterSize(out, bufSize)
+	buf := make([]byte, 0, 24)
+
+	for h.Len() > 0 {
+		top := h[0]
+		if asText {
+			buf = strconv.AppendInt(buf[:0], int64(top.value), 10)
+			buf = append(buf, '\n')
+		} else {
+			buf = binary.LittleEndian.AppendUint64(buf[:0], uint64(top.value))
+		}
+		if _, err := writer.Write(buf); err != nil {
+			return err
+		}
+
+		ok, err := top.next()
+		if err != nil {
+			return err
+		}
+		if ok {
+			heap.Fix(&h, 0)
+		} else {
+			top.file.Close()
+			heap.Pop(&h)
+		}
+	}
+
+	if err := writer.Flush(); err != nil {
+		return err
+	}
+	return out.Close()
+}
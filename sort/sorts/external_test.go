@@ -0,0 +1,91 @@
+package sorts
+
+import (
+	"math/rand"
+	"os"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+// writeTextInput 한 줄에 정수 하나인 입력 파일 생성 (빈 줄 포함)
+func writeTextInput(t *testing.T, data []int) string {
+	t.Helper()
+	var b strings.Builder
+	for i, v := range data {
+		if i%97 == 0 {
+			b.WriteString("\n")
+		}
+		b.WriteString(strconv.Itoa(v))
+		b.WriteString("\n")
+	}
+	path := filepath.Join(t.TempDir(), "input.txt")
+	if err := os.WriteFile(path, []byte(b.String()), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
+
+func readTextOutput(t *testing.T, path string) []int {
+	t.Helper()
+	raw, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var data []int
+	for _, line := range strings.Fields(string(raw)) {
+		v, err := strconv.Atoi(line)
+		if err != nil {
+			t.Fatal(err)
+		}
+		data = append(data, v)
+	}
+	return data
+}
+
+func TestExternalSort(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	data := make([]int, 5000)
+	for i := range data {
+		data[i] = rng.Intn(2000) - 1000
+	}
+	want := slices.Sorted(slices.Values(data))
+	input := writeTextInput(t, data)
+
+	for _, tc := range []struct {
+		name   string
+		opts   ExternalSortOptions
+		runs   int
+		passes int
+	}{
+		// 청크 100개 → 런 50개, 예산이 병합 버퍼 3개에도 못 미쳐 MaxFanIn 대신 2개씩 병합:
+		// 50 → 25 → 13 → 7 → 4 → 2 → 출력
+		{"다중 병합 패스", ExternalSortOptions{MemoryBudget: 100 * intBytes, MaxFanIn: 4}, 50, 6},
+		// 예산 16384 바이트는 4096 바이트 버퍼 4개 → 런 3개를 한 번에 병합
+		{"한 번에 병합", ExternalSortOptions{MemoryBudget: 2048 * intBytes}, 3, 1},
+		{"MaxFanIn 적용", ExternalSortOptions{MemoryBudget: 2048 * intBytes, MaxFanIn: 2}, 3, 2},
+		{"메모리 안에서 정렬", ExternalSortOptions{MemoryBudget: 10000 * intBytes}, 0, 0},
+	} {
+		tc.opts.TempDir = t.TempDir()
+		output := filepath.Join(t.TempDir(), "output.txt")
+		stats, err := ExternalSort(input, output, tc.opts)
+		if err != nil {
+			t.Fatalf("%s: %v", tc.name, err)
+		}
+		if stats.Elements != len(data) || stats.Runs != tc.runs || stats.MergePasses != tc.passes {
+			t.Errorf("%s: 통계 %+v, 기대 원소 %d 런 %d 병합 패스 %d", tc.name, stats, len(data), tc.runs, tc.passes)
+		}
+		if got := readTextOutput(t, output); !slices.Equal(got, want) {
+			t.Errorf("%s: 결과가 정렬된 입력과 다름 (%d개, 기대 %d개)", tc.name, len(got), len(want))
+		}
+		if left, _ := os.ReadDir(tc.opts.TempDir); len(left) != 0 {
+			t.Errorf("%s: 임시 파일이 남음: %d개", tc.name, len(left))
+		}
+	}
+
+	if _, err := ExternalSort(input, filepath.Join(t.TempDir(), "out.txt"), ExternalSortOptions{MemoryBudget: 4}); err == nil {
+		t.Error("원소 하나보다 작은 메모리 예산이 허용됨")
+	}
+}
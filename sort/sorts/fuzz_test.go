@@ -0,0 +1,154 @@
+package sorts
+
+import (
+	"cmp"
+	"encoding/binary"
+	"slices"
+	"strings"
+	"testing"
+)
+
+// fuzzAlgorithms 퍼징 대상 정렬 알고리즘 (결과를 새 슬라이스로 돌려주는 것은 그대로 반환)
+var fuzzAlgorithms = []struct {
+	name string
+	sort func([]int) []int
+}{
+	{"quicksort", func(a []int) []int { QuickSort(a); return a }},
+	{"parallel_quicksort", func(a []int) []int { ParallelQuickSort(a); return a }},
+	{"mergesort", MergeSort[int]},
+	{"parallel_mergesort", ParallelMergeSort[int]},
+	{"quicksort_func", func(a []int) []int { QuickSortFunc(a, cmp.Compare[int]); return a }},
+	{"parallel_quicksort_func", func(a []int) []int { ParallelQuickSortFunc(a, cmp.Compare[int]); return a }},
+	{"mergesort_func", func(a []int) []int { return MergeSortFunc(a, cmp.Compare[int]) }},
+	{"parallel_mergesort_func", func(a []int) []int { return ParallelMergeSortFunc(a, cmp.Compare[int]) }},
+	{"mergesort_buffered", func(a []int) []int { MergeSortBuffered(a); return a }},
+	{"parallel_mergesort_buffered", func(a []int) []int { ParallelMergeSortBuffered(a); return a }},
+	// 샘플 정렬은 임계값 아래에서 인트로소트로 넘어가므로 내부 함수로 분할 경로를 직접 검사
+	{"parallel_samplesort", func(a []int) []int { parallelSampleSort(DefaultScheduler(), a, 4, 2); return a }},
+	{"pdqsort", func(a []int) []int { PdqSort(a); return a }},
+	{"parallel_pdqsort", func(a []int) []int { ParallelPdqSort(a); return a }},
+	{"pdqsort_func", func(a []int) []int { PdqSortFunc(a, cmp.Compare[int]); return a }},
+	{"parallel_pdqsort_func", func(a []int) []int { ParallelPdqSortFunc(a, cmp.Compare[int]); return a }},
+	{"natural_mergesort", func(a []int) []int { NaturalMergeSort(a); return a }},
+	// 병렬 자연 병합 정렬도 작은 입력은 순차로 넘어가므로 청크 수를 고정해 병합 경로를 검사
+	{"parallel_natural_mergesort", func(a []int) []int {
+		buf := make([]int, len(a))
+		DefaultScheduler().Run(func(w *Worker) { parallelNaturalMergeSort(w, a, buf, max(1, min(4, len(a)))) })
+		return a
+	}},
+	{"natural_mergesort_func", func(a []int) []int { NaturalMergeSortFunc(a, cmp.Compare[int]); return a }},
+	{"parallel_natural_mergesort_func", func(a []int) []int { ParallelNaturalMergeSortFunc(a, cmp.Compare[int]); return a }},
+}
+
+// decodeFuzzInts 퍼저 입력을 정수 슬라이스로 변환
+// wide 이면 8바이트씩 int64 로, 아니면 1바이트씩 int8 로 읽어 중복값이 많은 입력을 만듭니다.
+func decodeFuzzInts(raw []byte, wide bool) []int {
+	if !wide {
+		data := make([]int, len(raw))
+		for i, b := range raw {
+			data[i] = int(int8(b))
+		}
+		return data
+	}
+
+	data := make([]int, len(raw)/8)
+	for i := range data {
+		data[i] = int(int64(binary.LittleEndian.Uint64(raw[i*8:])))
+	}
+	return data
+}
+
+func FuzzSortAlgorithms(f *testing.F) {
+	f.Add([]byte{}, false)
+	f.Add([]byte{3, 1, 2}, false)
+	f.Add([]byte("the quick brown fox jumps over the lazy dog"), false)
+	f.Add(binary.LittleEndian.AppendUint64(binary.LittleEndian.AppendUint64(nil, 1<<63), 1), true)
+
+	// 병렬 경로(임계값 이상)도 시드에 포함
+	large := make([]byte, 0, 4096*8)
+	for i := range 4096 {
+		large = binary.LittleEndian.AppendUint64(large, uint64(i*7919%4099))
+	}
+	f.Add(large, true)
+
+	f.Fuzz(func(t *testing.T, raw []byte, wide bool) {
+		input := decodeFuzzInts(raw, wide)
+		want := slices.Clone(input)
+		slices.Sort(want)
+
+		for _, algo := range fuzzAlgorithms {
+			got := algo.sort(slices.Clone(input))
+			if !slices.Equal(got, want) {
+				t.Fatalf("%s: 결과가 slices.Sort 와 다름 (n=%d)\n got: %v\nwant: %v",
+					algo.name, len(input), truncate(got), truncate(want))
+			}
+		}
+	})
+}
+
+// fuzzKeyAlgorithms 퍼징 대상 문자열 정렬 알고리즘
+var fuzzKeyAlgorithms = []struct {
+	name string
+	sort func([]string)
+}{
+	{"multikey_quicksort", MultikeyQuickSort[string]},
+	{"parallel_multikey_quicksort", ParallelMultikeyQuickSort[string]},
+	{"msd_radixsort", MSDRadixSort[string]},
+	{"parallel_msd_radixsort", ParallelMSDRadixSort[string]},
+}
+
+// decodeFuzzKeys 퍼저 입력을 sep 바이트로 잘라 키 목록으로 변환
+// 빈 키와 서로의 접두사인 키가 자주 나오도록 구분자는 입력에서 고릅니다.
+// repeat 만큼 이어 붙여 병렬 경로(parallelKeyThreshold 이상)도 검사합니다.
+func decodeFuzzKeys(raw []byte, sep byte, repeat uint8) []string {
+	keys := strings.Split(string(raw), string([]byte{sep}))
+	out := make([]string, 0, len(keys)*(int(repeat)+1))
+	for r := range int(repeat) + 1 {
+		for _, k := range keys {
+			if r%2 == 1 {
+				k += string(byte(r)) // 반복마다 조금씩 다른 키
+			}
+			out = append(out, k)
+		}
+	}
+	return out
+}
+
+func FuzzKeySorts(f *testing.F) {
+	f.Add([]byte{}, byte(','), uint8(0))
+	f.Add([]byte("b,a,,ab,abc,a,ba"), byte(','), uint8(0))
+	f.Add([]byte("0x0001\n0x0002\n0x0001\n0x00\n0x\n0x0010\n0x0100\n0x1000\n0x0011\n\n0x0002"), byte('\n'), uint8(255))
+	f.Add([]byte{0, 255, 0, 0, 1, 255, 255, 0, 128}, byte(1), uint8(200))
+
+	f.Fuzz(func(t *testing.T, raw []byte, sep byte, repeat uint8) {
+		input := decodeFuzzKeys(raw, sep, repeat)
+		want := slices.Clone(input)
+		slices.Sort(want)
+
+		for _, algo := range fuzzKeyAlgorithms {
+			got := slices.Clone(input)
+			algo.sort(got)
+			if !slices.Equal(got, want) {
+				t.Fatalf("%s: 결과가 slices.Sort 와 다름 (n=%d)\n got: %q\nwant: %q",
+					algo.name, len(input), got[:min(len(got), 16)], want[:min(len(want), 16)])
+			}
+		}
+
+		// []byte 키도 같은 순서
+		byteKeys := make([][]byte, len(input))
+		for i, k := range input {
+			byteKeys[i] = []byte(k)
+		}
+		MultikeyQuickSort(byteKeys)
+		for i := range byteKeys {
+			if string(byteKeys[i]) != want[i] {
+				t.Fatalf("multikey_quicksort []byte: %d 번째 키 %q, 기대 %q", i, byteKeys[i], want[i])
+			}
+		}
+	})
+}
+
+// truncate 실패 메시지용으로 앞부분만 남김
+func truncate(data []int) []int {
+	return data[:min(len(data), 32)]
+}
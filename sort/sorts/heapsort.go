@@ -0,0 +1,70 @@
+package sorts
+
+import "cmp"
+
+// heapSort arr[low..high] 힙정렬 (인트로소트 폴백용, 최악 O(n log n))
+func heapSort[T cmp.Ordered](arr []T, low, high int) {
+	data := arr[low : high+1]
+	n := len(data)
+
+	// 최대 힙 구성
+	for i := n/2 - 1; i >= 0; i-- {
+		siftDown(data, i, n)
+	}
+
+	// 최댓값을 뒤로 보내며 힙 크기 축소
+	for end := n - 1; end > 0; end-- {
+		data[0], data[end] = data[end], data[0]
+		siftDown(data, 0, end)
+	}
+}
+
+// siftDown data[root] 를 힙 속성이 만족될 때까지 아래로 내림 (크기 n)
+func siftDown[T cmp.Ordered](data []T, root, n int) {
+	for {
+		child := 2*root + 1
+		if child >= n {
+			return
+		}
+		if child+1 < n && data[child] < data[child+1] {
+			child++
+		}
+		if !(data[root] < data[child]) {
+			return
+		}
+		data[root], data[child] = data[child], data[root]
+		root = child
+	}
+}
+
+// heapSortFunc 비교 함수 기반 힙정렬
+func heapSortFunc[T any](arr []T, low, high int, cmp func(a, b T) int) {
+	data := arr[low : high+1]
+	n := len(data)
+
+	for i := n/2 - 1; i >= 0; i-- {
+		siftDownFunc(data, i, n, cmp)
+	}
+
+	for end := n - 1; end > 0; end-- {
+		data[0], data[end] = data[end], data[0]
+		siftDownFunc(data, 0, end, cmp)
+	}
+}
+
+func siftDownFunc[T any](data []T, root, n int, cmp func(a, b T) int) {
+	for {
+		child := 2*root + 1
+		if child >= n {
+			return
+		}
+		if child+1 < n && cmp(data[child], data[child+1]) < 0 {
+			child++
+		}
+		if cmp(data[root], data[child]) >= 0 {
+			return
+		}
+		data[root], data[child] = data[child], data[root]
+		root = child
+	}
+}
@@ -0,0 +1,58 @@
+package sorts
+
+import (
+	"cmp"
+	"math/bits"
+)
+
+// 깊이 제한 없음 (기존 퀵소트 동작)
+const noDepthLimit = -1
+
+// introDepthLimit 인트로소트 깊이 예산 2·log2(n)
+func introDepthLimit(n int) int {
+	return 2 * bits.Len(uint(n))
+}
+
+// IntroSort 깊이 제한이 있는 퀵소트 (인트로소트)
+// 분할 깊이가 2·log2(n) 을 넘으면 해당 구간을 힙정렬로 처리하므로
+// median-of-3 킬러 같은 적대적 입력에서도 O(n log n) 을 보장합니다.
+func IntroSort[T cmp.Ordered](arr []T) {
+	if len(arr) < 2 {
+		return
+	}
+	quickSortHelper(arr, 0, len(arr)-1, introDepthLimit(len(arr)), currentTuning())
+}
+
+// IntroSortFunc 비교 함수 기반 인트로소트
+func IntroSortFunc[T any](arr []T, cmp func(a, b T) int) {
+	if len(arr) < 2 {
+		return
+	}
+	quickSortHelperFunc(arr, 0, len(arr)-1, introDepthLimit(len(arr)), currentTuning(), cmp)
+}
+
+// ParallelIntroSort 깊이 제한이 있는 병렬 퀵소트
+func ParallelIntroSort[T cmp.Ordered](arr []T) {
+	if len(arr) < 2 {
+		return
+	}
+
+	DefaultScheduler().Run(func(w *Worker) {
+		var g TaskGroup
+		parallelQuickSortHelper(w, &g, arr, 0, len(arr)-1, introDepthLimit(len(arr)), currentTuning())
+		w.Wait(&g)
+	})
+}
+
+// ParallelIntroSortFunc 비교 함수 기반 병렬 인트로소트
+func ParallelIntroSortFunc[T any](arr []T, cmp func(a, b T) int) {
+	if len(arr) < 2 {
+		return
+	}
+
+	DefaultScheduler().Run(func(w *Worker) {
+		var g TaskGroup
+		parallelQuickSortHelperFunc(w, &g, arr, 0, len(arr)-1, introDepthLimit(len(arr)), currentTuning(), cmp)
+		w.Wait(&g)
+	})
+}
@@ -0,0 +1,75 @@
+package sorts
+
+import (
+	"cmp"
+	"math/rand"
+	"slices"
+	"testing"
+)
+
+// introsortInputs 무작위, 중복, 정렬, 역순, 톱니 입력
+func introsortInputs(n int) map[string][]int {
+	rng := rand.New(rand.NewSource(int64(n)))
+	inputs := map[string][]int{}
+	random, dups, sorted, reversed, sawtooth := make([]int, n), make([]int, n), make([]int, n), make([]int, n), make([]int, n)
+	for i := range n {
+		random[i] = rng.Int() - rng.Int()
+		dups[i] = rng.Intn(4)
+		sorted[i] = i
+		reversed[i] = n - i
+		sawtooth[i] = i % 17
+	}
+	inputs["무작위"], inputs["중복"], inputs["정렬"], inputs["역순"], inputs["톱니"] = random, dups, sorted, reversed, sawtooth
+	return inputs
+}
+
+func TestIntroSortAndHeapSort(t *testing.T) {
+	for name, sort := range map[string]func([]int){
+		"introsort":          IntroSort[int],
+		"introsort_func":     func(a []int) { IntroSortFunc(a, cmp.Compare[int]) },
+		"parallel_introsort": ParallelIntroSort[int],
+		"parallel_introsort_func": func(a []int) {
+			ParallelIntroSortFunc(a, cmp.Compare[int])
+		},
+		// 깊이 예산이 1 이면 첫 분할 뒤 양쪽 모두 힙정렬로 넘어가므로 힙정렬 전환 경로를 검사
+		"introsort_heap_fallback": func(a []int) {
+			if len(a) > 1 {
+				quickSortHelper(a, 0, len(a)-1, 1, currentTuning())
+			}
+		},
+		"introsort_func_heap_fallback": func(a []int) {
+			if len(a) > 1 {
+				quickSortHelperFunc(a, 0, len(a)-1, 1, currentTuning(), cmp.Compare[int])
+			}
+		},
+		"heapsort": func(a []int) {
+			if len(a) > 1 {
+				heapSort(a, 0, len(a)-1)
+			}
+		},
+		"heapsort_func": func(a []int) {
+			if len(a) > 1 {
+				heapSortFunc(a, 0, len(a)-1, cmp.Compare[int])
+			}
+		},
+	} {
+		for _, n := range []int{0, 1, 2, 17, 1000, 20000} {
+			for dist, input := range introsortInputs(n) {
+				got := slices.Clone(input)
+				sort(got)
+				if !slices.Equal(got, slices.Sorted(slices.Values(input))) {
+					t.Fatalf("%s: %s 입력 %d개 정렬 결과가 다름", name, dist, n)
+				}
+			}
+		}
+	}
+}
+
+// heapSort 는 [low, high] 구간 밖을 건드리지 않아야 함 (인트로소트는 부분 구간에만 호출)
+func TestHeapSortRange(t *testing.T) {
+	arr := []int{9, 8, 7, 6, 5, 4, 3, 2, 1, 0}
+	heapSort(arr, 2, 7)
+	if want := []int{9, 8, 2, 3, 4, 5, 6, 7, 1, 0}; !slices.Equal(arr, want) {
+		t.Fatalf("heapSort(2, 7) = %v, 기대 %v", arr, want)
+	}
+}
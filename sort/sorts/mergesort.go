@@ -0,0 +1,60 @@
+package sorts
+
+import "cmp"
+
+// MergeSort 최적화된 머지소트
+// 정렬된 결과를 새 슬라이스로 반환합니다 (길이가 1 이하이면 arr 그대로 반환).
+func MergeSort[T cmp.Ordered](arr []T) []T {
+	if len(arr) <= 1 {
+		return arr
+	}
+	return mergeSort(arr, currentTuning())
+}
+
+// mergeSort MergeSort 의 재귀 본체 (t 는 진입점에서 한 번 읽은 임계값)
+func mergeSort[T cmp.Ordered](arr []T, t *Tuning) []T {
+	if len(arr) <= 1 {
+		return arr
+	}
+
+	// 작은 배열은 삽입정렬 또는 정렬 네트워크 사용
+	if len(arr) <= t.InsertionCutoff {
+		result := make([]T, len(arr))
+		copy(result, arr)
+		sortSmall(result, 0, len(result)-1, t.BaseCase)
+		return result
+	}
+
+	mid := len(arr) / 2
+	left := mergeSort(arr[:mid], t)
+	right := mergeSort(arr[mid:], t)
+
+	return merge(left, right)
+}
+
+// merge 최적화된 병합
+func merge[T cmp.Ordered](left, right []T) []T {
+	result := make([]T, 0, len(left)+len(right))
+	i, j := 0, 0
+
+	// 더 효율적인 병합 루프
+	for i < len(left) && j < len(right) {
+		if left[i] <= right[j] {
+			result = append(result, left[i])
+			i++
+		} else {
+			result = append(result, right[j])
+			j++
+		}
+	}
+
+	// 남은 요소들 한 번에 추가
+	if i < len(left) {
+		result = append(result, left[i:]...)
+	}
+	if j < len(right) {
+		result = append(result, right[j:]...)
+	}
+
+	return result
+}
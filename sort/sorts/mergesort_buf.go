@@ -0,0 +1,52 @@
+package sorts
+
+import "cmp"
+
+// MergeSortBuffered 보조 버퍼를 한 번만 할당하는 머지소트 (제자리 결과)
+// 크기 n 의 버퍼 하나를 원본과 번갈아(ping-pong) 사용하므로
+// 재귀 단계마다 새 슬라이스를 만드는 MergeSort 와 달리 할당이 한 번뿐입니다.
+func MergeSortBuffered[T cmp.Ordered](arr []T) {
+	if len(arr) <= 1 {
+		return
+	}
+
+	buf := make([]T, len(arr))
+	copy(buf, arr)
+	mergeSortPingPong(buf, arr, currentTuning())
+}
+
+// mergeSortPingPong src 와 dst 는 같은 내용을 가져야 하며, 정렬 결과는 dst 에 남습니다.
+// 각 절반을 src 쪽으로 정렬한 뒤 dst 로 병합하므로 src 는 작업 공간으로 쓰입니다.
+func mergeSortPingPong[T cmp.Ordered](src, dst []T, t *Tuning) {
+	// 작은 배열은 삽입정렬 또는 정렬 네트워크 사용
+	if len(dst) <= t.InsertionCutoff {
+		sortSmall(dst, 0, len(dst)-1, t.BaseCase)
+		return
+	}
+
+	mid := len(dst) / 2
+	mergeSortPingPong(dst[:mid], src[:mid], t)
+	mergeSortPingPong(dst[mid:], src[mid:], t)
+
+	mergeInto(src[:mid], src[mid:], dst)
+}
+
+// mergeInto 정렬된 left, right 를 dst 에 병합 (할당 없음)
+func mergeInto[T cmp.Ordered](left, right, dst []T) {
+	i, j, k := 0, 0, 0
+
+	for i < len(left) && j < len(right) {
+		if left[i] <= right[j] {
+			dst[k] = left[i]
+			i++
+		} else {
+			dst[k] = right[j]
+			j++
+		}
+		k++
+	}
+
+	// 남은 요소들 한 번에 복사
+	k += copy(dst[k:], left[i:])
+	copy(dst[k:], right[j:])
+}
@@ -0,0 +1,72 @@
+package sorts
+
+// MergeSortFunc 비교 함수 기반 머지소트 (안정 정렬)
+func MergeSortFunc[T any](arr []T, cmp func(a, b T) int) []T {
+	if len(arr) <= 1 {
+		return arr
+	}
+	return mergeSortFunc(arr, currentTuning(), cmp)
+}
+
+func mergeSortFunc[T any](arr []T, t *Tuning, cmp func(a, b T) int) []T {
+	if len(arr) <= 1 {
+		return arr
+	}
+
+	if len(arr) <= t.InsertionCutoff {
+		result := make([]T, len(arr))
+		copy(result, arr)
+		insertionSortFunc(result, 0, len(result)-1, cmp)
+		return result
+	}
+
+	mid := len(arr) / 2
+	left := mergeSortFunc(arr[:mid], t, cmp)
+	right := mergeSortFunc(arr[mid:], t, cmp)
+
+	return mergeFunc(left, right, cmp)
+}
+
+// mergeFunc 비교 함수 기반 병합 (같은 값은 왼쪽 우선)
+func mergeFunc[T any](left, right []T, cmp func(a, b T) int) []T {
+	result := make([]T, 0, len(left)+len(right))
+	i, j := 0, 0
+
+	for i < len(left) && j < len(right) {
+		if cmp(left[i], right[j]) <= 0 {
+			result = append(result, left[i])
+			i++
+		} else {
+			result = append(result, right[j])
+			j++
+		}
+	}
+
+	if i < len(left) {
+		result = append(result, left[i:]...)
+	}
+	if j < len(right) {
+		result = append(result, right[j:]...)
+	}
+
+	return result
+}
+
+// mergeIntoFunc 정렬된 left, right 를 dst 에 병합 (비교 함수 버전, 할당 없음)
+func mergeIntoFunc[T any](left, right, dst []T, cmp func(a, b T) int) {
+	i, j, k := 0, 0, 0
+
+	for i < len(left) && j < len(right) {
+		if cmp(left[i], right[j]) <= 0 {
+			dst[k] = left[i]
+			i++
+		} else {
+			dst[k] = right[j]
+			j++
+		}
+		k++
+	}
+
+	k += copy(dst[k:], left[i:])
+	copy(dst[k:], right[j:])
+}
@@ -0,0 +1,657 @@
+package sorts
+
+import "cmp"
+
+// 정렬 네트워크 (작은 구간의 기저 정렬)
+// 삽입정렬은 원소마다 이동 횟수가 데이터에 따라 달라 분기 예측이 자주 틀립니다.
+// 정렬 네트워크는 입력과 무관하게 정해진 위치 쌍만 비교 교환하므로 분기가 없고,
+// 교환도 조건부 이동(CMOV)으로 컴파일됩니다. 네트워크는 크기별로 알려진 최소 비교 수의
+// 것을 쓰며(13 은 16 네트워크에서 잘라낸 것으로 최소보다 1번 많음), 0-1 원리로 검증했습니다.
+//
+// 정렬 네트워크는 안정 정렬이 아니지만 cmp.Ordered 값은 같으면 구별할 수 없으므로
+// (부동소수점 +0 과 -0 제외) 머지소트의 결과도 달라지지 않습니다.
+
+// MaxNetworkSize 정렬 네트워크로 정렬할 수 있는 최대 구간 크기
+const MaxNetworkSize = 16
+
+// sortSmall InsertionCutoff 이하 구간 arr[low..high] 를 base 방식으로 정렬
+// Tuning.Validate 가 정렬 네트워크일 때 InsertionCutoff <= MaxNetworkSize 를 보장합니다.
+func sortSmall[T cmp.Ordered](arr []T, low, high int, base BaseCase) {
+	if base == BaseCaseNetwork {
+		networkSort(arr[low : high+1])
+		return
+	}
+	insertionSort(arr, low, high)
+}
+
+// networkSort 길이가 MaxNetworkSize 이하인 a 를 정렬 네트워크로 정렬
+func networkSort[T cmp.Ordered](a []T) {
+	switch len(a) {
+	case 0, 1:
+	case 2:
+		sortNetwork2(a)
+	case 3:
+		sortNetwork3(a)
+	case 4:
+		sortNetwork4(a)
+	case 5:
+		sortNetwork5(a)
+	case 6:
+		sortNetwork6(a)
+	case 7:
+		sortNetwork7(a)
+	case 8:
+		sortNetwork8(a)
+	case 9:
+		sortNetwork9(a)
+	case 10:
+		sortNetwork10(a)
+	case 11:
+		sortNetwork11(a)
+	case 12:
+		sortNetwork12(a)
+	case 13:
+		sortNetwork13(a)
+	case 14:
+		sortNetwork14(a)
+	case 15:
+		sortNetwork15(a)
+	case 16:
+		sortNetwork16(a)
+	default:
+		panic("sorts: 정렬 네트워크 크기 초과")
+	}
+}
+
+// compareSwap a[i] > a[j] 이면 교환 (i < j)
+// 항상 두 위치에 다시 쓰는 형태라 분기 대신 조건부 이동으로 컴파일됩니다.
+// min/max 내장 함수는 NaN 을 퍼뜨려 값을 잃으므로 쓰지 않습니다.
+func compareSwap[T cmp.Ordered](a []T, i, j int) {
+	x, y := a[i], a[j]
+	if y < x {
+		x, y = y, x
+	}
+	a[i], a[j] = x, y
+}
+
+// sortNetwork2 2개 정렬 네트워크 (비교 교환 1번)
+func sortNetwork2[T cmp.Ordered](a []T) {
+	_ = a[1]
+
+	compareSwap(a, 0, 1)
+}
+
+// sortNetwork3 3개 정렬 네트워크 (비교 교환 3번)
+func sortNetwork3[T cmp.Ordered](a []T) {
+	_ = a[2]
+
+	compareSwap(a, 0, 2)
+
+	compareSwap(a, 0, 1)
+
+	compareSwap(a, 1, 2)
+}
+
+// sortNetwork4 4개 정렬 네트워크 (비교 교환 5번)
+func sortNetwork4[T cmp.Ordered](a []T) {
+	_ = a[3]
+
+	compareSwap(a, 0, 2)
+	compareSwap(a, 1, 3)
+
+	compareSwap(a, 0, 1)
+	compareSwap(a, 2, 3)
+
+	compareSwap(a, 1, 2)
+}
+
+// sortNetwork5 5개 정렬 네트워크 (비교 교환 9번)
+func sortNetwork5[T cmp.Ordered](a []T) {
+	_ = a[4]
+
+	compareSwap(a, 0, 3)
+	compareSwap(a, 1, 4)
+
+	compareSwap(a, 0, 2)
+	compareSwap(a, 1, 3)
+
+	compareSwap(a, 0, 1)
+	compareSwap(a, 2, 4)
+
+	compareSwap(a, 1, 2)
+	compareSwap(a, 3, 4)
+
+	compareSwap(a, 2, 3)
+}
+
+// sortNetwork6 6개 정렬 네트워크 (비교 교환 12번)
+func sortNetwork6[T cmp.Ordered](a []T) {
+	_ = a[5]
+
+	compareSwap(a, 0, 5)
+	compareSwap(a, 1, 3)
+	compareSwap(a, 2, 4)
+
+	compareSwap(a, 1, 2)
+	compareSwap(a, 3, 4)
+
+	compareSwap(a, 0, 3)
+	compareSwap(a, 2, 5)
+
+	compareSwap(a, 0, 1)
+	compareSwap(a, 2, 3)
+	compareSwap(a, 4, 5)
+
+	compareSwap(a, 1, 2)
+	compareSwap(a, 3, 4)
+}
+
+// sortNetwork7 7개 정렬 네트워크 (비교 교환 16번)
+func sortNetwork7[T cmp.Ordered](a []T) {
+	_ = a[6]
+
+	compareSwap(a, 0, 6)
+	compareSwap(a, 2, 3)
+	compareSwap(a, 4, 5)
+
+	compareSwap(a, 0, 2)
+	compareSwap(a, 1, 4)
+	compareSwap(a, 3, 6)
+
+	compareSwap(a, 0, 1)
+	compareSwap(a, 2, 5)
+	compareSwap(a, 3, 4)
+
+	compareSwap(a, 1, 2)
+	compareSwap(a, 4, 6)
+
+	compareSwap(a, 2, 3)
+	compareSwap(a, 4, 5)
+
+	compareSwap(a, 1, 2)
+	compareSwap(a, 3, 4)
+	compareSwap(a, 5, 6)
+}
+
+// sortNetwork8 8개 정렬 네트워크 (비교 교환 19번)
+func sortNetwork8[T cmp.Ordered](a []T) {
+	_ = a[7]
+
+	compareSwap(a, 0, 2)
+	compareSwap(a, 1, 3)
+	compareSwap(a, 4, 6)
+	compareSwap(a, 5, 7)
+
+	compareSwap(a, 0, 4)
+	compareSwap(a, 1, 5)
+	compareSwap(a, 2, 6)
+	compareSwap(a, 3, 7)
+
+	compareSwap(a, 0, 1)
+	compareSwap(a, 2, 3)
+	compareSwap(a, 4, 5)
+	compareSwap(a, 6, 7)
+
+	compareSwap(a, 2, 4)
+	compareSwap(a, 3, 5)
+
+	compareSwap(a, 1, 4)
+	compareSwap(a, 3, 6)
+
+	compareSwap(a, 1, 2)
+	compareSwap(a, 3, 4)
+	compareSwap(a, 5, 6)
+}
+
+// sortNetwork9 9개 정렬 네트워크 (비교 교환 25번)
+func sortNetwork9[T cmp.Ordered](a []T) {
+	_ = a[8]
+
+	compareSwap(a, 0, 3)
+	compareSwap(a, 1, 7)
+	compareSwap(a, 2, 5)
+	compareSwap(a, 4, 8)
+
+	compareSwap(a, 0, 7)
+	compareSwap(a, 2, 4)
+	compareSwap(a, 3, 8)
+	compareSwap(a, 5, 6)
+
+	compareSwap(a, 0, 2)
+	compareSwap(a, 1, 3)
+	compareSwap(a, 4, 5)
+	compareSwap(a, 7, 8)
+
+	compareSwap(a, 1, 4)
+	compareSwap(a, 3, 6)
+	compareSwap(a, 5, 7)
+
+	compareSwap(a, 0, 1)
+	compareSwap(a, 2, 4)
+	compareSwap(a, 3, 5)
+	compareSwap(a, 6, 8)
+
+	compareSwap(a, 2, 3)
+	compareSwap(a, 4, 5)
+	compareSwap(a, 6, 7)
+
+	compareSwap(a, 1, 2)
+	compareSwap(a, 3, 4)
+	compareSwap(a, 5, 6)
+}
+
+// sortNetwork10 10개 정렬 네트워크 (비교 교환 29번)
+func sortNetwork10[T cmp.Ordered](a []T) {
+	_ = a[9]
+
+	compareSwap(a, 0, 8)
+	compareSwap(a, 1, 9)
+	compareSwap(a, 2, 7)
+	compareSwap(a, 3, 5)
+	compareSwap(a, 4, 6)
+
+	compareSwap(a, 0, 2)
+	compareSwap(a, 1, 4)
+	compareSwap(a, 5, 8)
+	compareSwap(a, 7, 9)
+
+	compareSwap(a, 0, 3)
+	compareSwap(a, 2, 4)
+	compareSwap(a, 5, 7)
+	compareSwap(a, 6, 9)
+
+	compareSwap(a, 0, 1)
+	compareSwap(a, 3, 6)
+	compareSwap(a, 8, 9)
+
+	compareSwap(a, 1, 5)
+	compareSwap(a, 2, 3)
+	compareSwap(a, 4, 8)
+	compareSwap(a, 6, 7)
+
+	compareSwap(a, 1, 2)
+	compareSwap(a, 3, 5)
+	compareSwap(a, 4, 6)
+	compareSwap(a, 7, 8)
+
+	compareSwap(a, 2, 3)
+	compareSwap(a, 4, 5)
+	compareSwap(a, 6, 7)
+
+	compareSwap(a, 3, 4)
+	compareSwap(a, 5, 6)
+}
+
+// sortNetwork11 11개 정렬 네트워크 (비교 교환 35번)
+func sortNetwork11[T cmp.Ordered](a []T) {
+	_ = a[10]
+
+	compareSwap(a, 0, 9)
+	compareSwap(a, 1, 6)
+	compareSwap(a, 2, 4)
+	compareSwap(a, 3, 7)
+	compareSwap(a, 5, 8)
+
+	compareSwap(a, 0, 1)
+	compareSwap(a, 3, 5)
+	compareSwap(a, 4, 10)
+	compareSwap(a, 6, 9)
+	compareSwap(a, 7, 8)
+
+	compareSwap(a, 1, 3)
+	compareSwap(a, 2, 5)
+	compareSwap(a, 4, 7)
+	compareSwap(a, 8, 10)
+
+	compareSwap(a, 0, 4)
+	compareSwap(a, 1, 2)
+	compareSwap(a, 3, 7)
+	compareSwap(a, 5, 9)
+	compareSwap(a, 6, 8)
+
+	compareSwap(a, 0, 1)
+	compareSwap(a, 2, 6)
+	compareSwap(a, 4, 5)
+	compareSwap(a, 7, 8)
+	compareSwap(a, 9, 10)
+
+	compareSwap(a, 2, 4)
+	compareSwap(a, 3, 6)
+	compareSwap(a, 5, 7)
+	compareSwap(a, 8, 9)
+
+	compareSwap(a, 1, 2)
+	compareSwap(a, 3, 4)
+	compareSwap(a, 5, 6)
+	compareSwap(a, 7, 8)
+
+	compareSwap(a, 2, 3)
+	compareSwap(a, 4, 5)
+	compareSwap(a, 6, 7)
+}
+
+// sortNetwork12 12개 정렬 네트워크 (비교 교환 39번)
+func sortNetwork12[T cmp.Ordered](a []T) {
+	_ = a[11]
+
+	compareSwap(a, 0, 8)
+	compareSwap(a, 1, 7)
+	compareSwap(a, 2, 6)
+	compareSwap(a, 3, 11)
+	compareSwap(a, 4, 10)
+	compareSwap(a, 5, 9)
+
+	compareSwap(a, 0, 1)
+	compareSwap(a, 2, 5)
+	compareSwap(a, 3, 4)
+	compareSwap(a, 6, 9)
+	compareSwap(a, 7, 8)
+	compareSwap(a, 10, 11)
+
+	compareSwap(a, 0, 2)
+	compareSwap(a, 1, 6)
+	compareSwap(a, 5, 10)
+	compareSwap(a, 9, 11)
+
+	compareSwap(a, 0, 3)
+	compareSwap(a, 1, 2)
+	compareSwap(a, 4, 6)
+	compareSwap(a, 5, 7)
+	compareSwap(a, 8, 11)
+	compareSwap(a, 9, 10)
+
+	compareSwap(a, 1, 4)
+	compareSwap(a, 3, 5)
+	compareSwap(a, 6, 8)
+	compareSwap(a, 7, 10)
+
+	compareSwap(a, 1, 3)
+	compareSwap(a, 2, 5)
+	compareSwap(a, 6, 9)
+	compareSwap(a, 8, 10)
+
+	compareSwap(a, 2, 3)
+	compareSwap(a, 4, 5)
+	compareSwap(a, 6, 7)
+	compareSwap(a, 8, 9)
+
+	compareSwap(a, 4, 6)
+	compareSwap(a, 5, 7)
+
+	compareSwap(a, 3, 4)
+	compareSwap(a, 5, 6)
+	compareSwap(a, 7, 8)
+}
+
+// sortNetwork13 13개 정렬 네트워크 (비교 교환 46번)
+func sortNetwork13[T cmp.Ordered](a []T) {
+	_ = a[12]
+
+	compareSwap(a, 0, 1)
+	compareSwap(a, 2, 3)
+	compareSwap(a, 4, 5)
+	compareSwap(a, 6, 7)
+	compareSwap(a, 8, 9)
+	compareSwap(a, 10, 11)
+
+	compareSwap(a, 0, 2)
+	compareSwap(a, 1, 3)
+	compareSwap(a, 4, 6)
+	compareSwap(a, 5, 7)
+	compareSwap(a, 8, 10)
+	compareSwap(a, 9, 11)
+
+	compareSwap(a, 0, 4)
+	compareSwap(a, 1, 5)
+	compareSwap(a, 2, 6)
+	compareSwap(a, 3, 7)
+	compareSwap(a, 8, 12)
+
+	compareSwap(a, 0, 8)
+	compareSwap(a, 1, 9)
+	compareSwap(a, 2, 10)
+	compareSwap(a, 3, 11)
+	compareSwap(a, 4, 12)
+
+	compareSwap(a, 5, 10)
+	compareSwap(a, 6, 9)
+	compareSwap(a, 3, 12)
+	compareSwap(a, 7, 11)
+	compareSwap(a, 1, 2)
+	compareSwap(a, 4, 8)
+
+	compareSwap(a, 1, 4)
+	compareSwap(a, 2, 8)
+	compareSwap(a, 5, 6)
+	compareSwap(a, 9, 10)
+
+	compareSwap(a, 2, 4)
+	compareSwap(a, 3, 8)
+	compareSwap(a, 7, 12)
+
+	compareSwap(a, 6, 8)
+	compareSwap(a, 10, 12)
+	compareSwap(a, 3, 5)
+	compareSwap(a, 7, 9)
+
+	compareSwap(a, 3, 4)
+	compareSwap(a, 5, 6)
+	compareSwap(a, 7, 8)
+	compareSwap(a, 9, 10)
+	compareSwap(a, 11, 12)
+
+	compareSwap(a, 6, 7)
+	compareSwap(a, 8, 9)
+}
+
+// sortNetwork14 14개 정렬 네트워크 (비교 교환 51번)
+func sortNetwork14[T cmp.Ordered](a []T) {
+	_ = a[13]
+
+	compareSwap(a, 0, 1)
+	compareSwap(a, 2, 3)
+	compareSwap(a, 4, 5)
+	compareSwap(a, 6, 7)
+	compareSwap(a, 8, 9)
+	compareSwap(a, 10, 11)
+	compareSwap(a, 12, 13)
+
+	compareSwap(a, 0, 2)
+	compareSwap(a, 1, 3)
+	compareSwap(a, 4, 6)
+	compareSwap(a, 5, 7)
+	compareSwap(a, 8, 10)
+	compareSwap(a, 9, 11)
+
+	compareSwap(a, 0, 4)
+	compareSwap(a, 1, 5)
+	compareSwap(a, 2, 6)
+	compareSwap(a, 3, 7)
+	compareSwap(a, 8, 12)
+	compareSwap(a, 9, 13)
+
+	compareSwap(a, 0, 8)
+	compareSwap(a, 1, 9)
+	compareSwap(a, 2, 10)
+	compareSwap(a, 3, 11)
+	compareSwap(a, 4, 12)
+	compareSwap(a, 5, 13)
+
+	compareSwap(a, 5, 10)
+	compareSwap(a, 6, 9)
+	compareSwap(a, 3, 12)
+	compareSwap(a, 7, 11)
+	compareSwap(a, 1, 2)
+	compareSwap(a, 4, 8)
+
+	compareSwap(a, 1, 4)
+	compareSwap(a, 7, 13)
+	compareSwap(a, 2, 8)
+	compareSwap(a, 5, 6)
+	compareSwap(a, 9, 10)
+
+	compareSwap(a, 2, 4)
+	compareSwap(a, 11, 13)
+	compareSwap(a, 3, 8)
+	compareSwap(a, 7, 12)
+
+	compareSwap(a, 6, 8)
+	compareSwap(a, 10, 12)
+	compareSwap(a, 3, 5)
+	compareSwap(a, 7, 9)
+
+	compareSwap(a, 3, 4)
+	compareSwap(a, 5, 6)
+	compareSwap(a, 7, 8)
+	compareSwap(a, 9, 10)
+	compareSwap(a, 11, 12)
+
+	compareSwap(a, 6, 7)
+	compareSwap(a, 8, 9)
+}
+
+// sortNetwork15 15개 정렬 네트워크 (비교 교환 56번)
+func sortNetwork15[T cmp.Ordered](a []T) {
+	_ = a[14]
+
+	compareSwap(a, 0, 1)
+	compareSwap(a, 2, 3)
+	compareSwap(a, 4, 5)
+	compareSwap(a, 6, 7)
+	compareSwap(a, 8, 9)
+	compareSwap(a, 10, 11)
+	compareSwap(a, 12, 13)
+
+	compareSwap(a, 0, 2)
+	compareSwap(a, 1, 3)
+	compareSwap(a, 4, 6)
+	compareSwap(a, 5, 7)
+	compareSwap(a, 8, 10)
+	compareSwap(a, 9, 11)
+	compareSwap(a, 12, 14)
+
+	compareSwap(a, 0, 4)
+	compareSwap(a, 1, 5)
+	compareSwap(a, 2, 6)
+	compareSwap(a, 3, 7)
+	compareSwap(a, 8, 12)
+	compareSwap(a, 9, 13)
+	compareSwap(a, 10, 14)
+
+	compareSwap(a, 0, 8)
+	compareSwap(a, 1, 9)
+	compareSwap(a, 2, 10)
+	compareSwap(a, 3, 11)
+	compareSwap(a, 4, 12)
+	compareSwap(a, 5, 13)
+	compareSwap(a, 6, 14)
+
+	compareSwap(a, 5, 10)
+	compareSwap(a, 6, 9)
+	compareSwap(a, 3, 12)
+	compareSwap(a, 13, 14)
+	compareSwap(a, 7, 11)
+	compareSwap(a, 1, 2)
+	compareSwap(a, 4, 8)
+
+	compareSwap(a, 1, 4)
+	compareSwap(a, 7, 13)
+	compareSwap(a, 2, 8)
+	compareSwap(a, 11, 14)
+	compareSwap(a, 5, 6)
+	compareSwap(a, 9, 10)
+
+	compareSwap(a, 2, 4)
+	compareSwap(a, 11, 13)
+	compareSwap(a, 3, 8)
+	compareSwap(a, 7, 12)
+
+	compareSwap(a, 6, 8)
+	compareSwap(a, 10, 12)
+	compareSwap(a, 3, 5)
+	compareSwap(a, 7, 9)
+
+	compareSwap(a, 3, 4)
+	compareSwap(a, 5, 6)
+	compareSwap(a, 7, 8)
+	compareSwap(a, 9, 10)
+	compareSwap(a, 11, 12)
+
+	compareSwap(a, 6, 7)
+	compareSwap(a, 8, 9)
+}
+
+// sortNetwork16 16개 정렬 네트워크 (비교 교환 60번)
+func sortNetwork16[T cmp.Ordered](a []T) {
+	_ = a[15]
+
+	compareSwap(a, 0, 1)
+	compareSwap(a, 2, 3)
+	compareSwap(a, 4, 5)
+	compareSwap(a, 6, 7)
+	compareSwap(a, 8, 9)
+	compareSwap(a, 10, 11)
+	compareSwap(a, 12, 13)
+	compareSwap(a, 14, 15)
+
+	compareSwap(a, 0, 2)
+	compareSwap(a, 1, 3)
+	compareSwap(a, 4, 6)
+	compareSwap(a, 5, 7)
+	compareSwap(a, 8, 10)
+	compareSwap(a, 9, 11)
+	compareSwap(a, 12, 14)
+	compareSwap(a, 13, 15)
+
+	compareSwap(a, 0, 4)
+	compareSwap(a, 1, 5)
+	compareSwap(a, 2, 6)
+	compareSwap(a, 3, 7)
+	compareSwap(a, 8, 12)
+	compareSwap(a, 9, 13)
+	compareSwap(a, 10, 14)
+	compareSwap(a, 11, 15)
+
+	compareSwap(a, 0, 8)
+	compareSwap(a, 1, 9)
+	compareSwap(a, 2, 10)
+	compareSwap(a, 3, 11)
+	compareSwap(a, 4, 12)
+	compareSwap(a, 5, 13)
+	compareSwap(a, 6, 14)
+	compareSwap(a, 7, 15)
+
+	compareSwap(a, 5, 10)
+	compareSwap(a, 6, 9)
+	compareSwap(a, 3, 12)
+	compareSwap(a, 13, 14)
+	compareSwap(a, 7, 11)
+	compareSwap(a, 1, 2)
+	compareSwap(a, 4, 8)
+
+	compareSwap(a, 1, 4)
+	compareSwap(a, 7, 13)
+	compareSwap(a, 2, 8)
+	compareSwap(a, 11, 14)
+	compareSwap(a, 5, 6)
+	compareSwap(a, 9, 10)
+
+	compareSwap(a, 2, 4)
+	compareSwap(a, 11, 13)
+	compareSwap(a, 3, 8)
+	compareSwap(a, 7, 12)
+
+	compareSwap(a, 6, 8)
+	compareSwap(a, 10, 12)
+	compareSwap(a, 3, 5)
+	compareSwap(a, 7, 9)
+
+	compareSwap(a, 3, 4)
+	compareSwap(a, 5, 6)
+	compareSwap(a, 7, 8)
+	compareSwap(a, 9, 10)
+	compareSwap(a, 11, 12)
+
+	compareSwap(a, 6, 7)
+	compareSwap(a, 8, 9)
+}
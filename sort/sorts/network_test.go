@@ -0,0 +1,94 @@
+package sorts
+
+import (
+	"math"
+	"math/rand"
+	"slices"
+	"testing"
+)
+
+// 0-1 원리: 모든 0/1 입력을 정렬하는 비교 교환 네트워크는 모든 입력을 정렬합니다.
+func TestNetworkSortZeroOne(t *testing.T) {
+	a := make([]int, MaxNetworkSize)
+	for n := 0; n <= MaxNetworkSize; n++ {
+		for bits := 0; bits < 1<<n; bits++ {
+			for i := range n {
+				a[i] = bits >> i & 1
+			}
+			networkSort(a[:n])
+			if !slices.IsSorted(a[:n]) {
+				t.Fatalf("크기 %d 네트워크가 %0*b 를 정렬하지 못함: %v", n, n, bits, a[:n])
+			}
+		}
+	}
+}
+
+// 중복값과 NaN 이 섞인 입력에서도 값을 잃지 않는지 확인
+func TestNetworkSortPreservesValues(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	for n := 0; n <= MaxNetworkSize; n++ {
+		for range 200 {
+			a := make([]float64, n)
+			for i := range a {
+				a[i] = float64(rng.Intn(4))
+			}
+			if n > 0 {
+				a[rng.Intn(n)] = math.NaN()
+			}
+			got := slices.Clone(a)
+			networkSort(got)
+
+			want := slices.DeleteFunc(slices.Clone(a), math.IsNaN)
+			rest := slices.DeleteFunc(slices.Clone(got), math.IsNaN)
+			slices.Sort(want)
+			slices.Sort(rest)
+			if !slices.Equal(rest, want) {
+				t.Fatalf("크기 %d: %v 정렬 결과 %v 에서 값이 달라짐", n, a, got)
+			}
+		}
+	}
+}
+
+func TestNetworkBaseCase(t *testing.T) {
+	orig, _ := CurrentTuning()
+	defer SetTuning(orig)
+
+	for _, tn := range []Tuning{
+		{InsertionCutoff: MaxNetworkSize + 1, BaseCase: BaseCaseNetwork, SmallThreshold: 2, MediumThreshold: 2, LargeThreshold: 2},
+		{InsertionCutoff: 16, BaseCase: "bitonic", SmallThreshold: 2, MediumThreshold: 2, LargeThreshold: 2},
+	} {
+		if err := SetTuning(tn); err == nil {
+			t.Errorf("%+v 가 허용됨", tn)
+		}
+	}
+
+	rng := rand.New(rand.NewSource(2))
+	arr := make([]int, 20000)
+	for i := range arr {
+		arr[i] = rng.Intn(5000)
+	}
+	want := slices.Sorted(slices.Values(arr))
+
+	for _, cutoff := range []int{2, 7, MaxNetworkSize} {
+		tn := DefaultTuning()
+		tn.InsertionCutoff, tn.BaseCase, tn.ParallelMinSize = cutoff, BaseCaseNetwork, 0
+		if err := SetTuning(tn); err != nil {
+			t.Fatal(err)
+		}
+		for name, sort := range map[string]func([]int){
+			"quicksort":          QuickSort[int],
+			"parallel_quicksort": ParallelQuickSort[int],
+			"mergesort": func(a []int) {
+				copy(a, MergeSort(a))
+			},
+			"mergesort_buffered":          MergeSortBuffered[int],
+			"parallel_mergesort_buffered": ParallelMergeSortBuffered[int],
+		} {
+			got := slices.Clone(arr)
+			sort(got)
+			if !slices.Equal(got, want) {
+				t.Errorf("%s: 정렬 네트워크 기저(cutoff %d)에서 결과가 다름", name, cutoff)
+			}
+		}
+	}
+}
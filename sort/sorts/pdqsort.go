@@ -0,0 +1,356 @@
+package sorts
+
+import (
+	"cmp"
+	"context"
+	"math/bits"
+)
+
+// 패턴 회피 퀵소트 (pdqsort) 설정
+const (
+	pdqInsertionCutoff = 24 // 이하 크기는 삽입정렬
+	pdqNintherCutoff   = 50 // 이상 크기는 ninther(중앙값의 중앙값)로 피벗 선택
+	pdqBlockSize       = 64 // 블록 분할에서 한 번에 검사하는 원소 수 (uint8 오프셋에 맞춤)
+	pdqPartialSteps    = 5  // 거의 정렬된 구간에서 삽입정렬로 고칠 최대 역전 수
+	pdqPartialMinSize  = 50 // 이보다 작은 구간은 부분 삽입정렬을 시도하지 않음
+)
+
+// PdqSort 패턴 회피 퀵소트 (pattern-defeating quicksort)
+// 분기 예측 실패를 줄이는 블록 분할(BlockQuicksort)로 나누고, 분할이 크게 치우치면
+// 몇 원소를 섞어 패턴을 깨며, 그래도 나쁜 분할이 log2(n) 번을 넘으면 힙정렬로 전환합니다.
+// 분할 중 한 번도 교환하지 않았으면(이미 분할된 구간) 부분 삽입정렬로 바로 끝내 보므로
+// 정렬된 입력은 O(n) 에, 중복이 많은 입력은 같은 값을 한 번에 모아 빠르게 처리합니다.
+func PdqSort[T cmp.Ordered](arr []T) {
+	if len(arr) < 2 {
+		return
+	}
+	pdqSortHelper(arr, 0, len(arr)-1, bits.Len(uint(len(arr))))
+}
+
+// ParallelPdqSort 블록 분할을 사용하는 병렬 패턴 회피 퀵소트
+func ParallelPdqSort[T cmp.Ordered](arr []T) {
+	if len(arr) < 2 {
+		return
+	}
+
+	DefaultScheduler().Run(func(w *Worker) {
+		var g TaskGroup
+		parallelPdqSortHelper(w, &g, arr, 0, len(arr)-1, bits.Len(uint(len(arr))), currentTuning())
+		w.Wait(&g)
+	})
+}
+
+// ParallelPdqSortContext ctx 가 취소되면 정렬을 중단하는 병렬 패턴 회피 퀵소트
+// 반환값과 중단 시 arr 의 상태는 ParallelQuickSortContext 와 같습니다.
+func ParallelPdqSortContext[T cmp.Ordered](ctx context.Context, arr []T) error {
+	if len(arr) < 2 {
+		return ctx.Err()
+	}
+
+	return DefaultScheduler().RunContext(ctx, func(w *Worker) {
+		var g TaskGroup
+		parallelPdqSortHelper(w, &g, arr, 0, len(arr)-1, bits.Len(uint(len(arr))), currentTuning())
+		w.Wait(&g)
+	})
+}
+
+// pdqSortHelper arr[low..high] 정렬, limit 은 남은 나쁜 분할 허용 횟수
+// arr[low-1] 은 (있다면) 구간의 모든 원소 이하이므로, 피벗이 그와 같으면 피벗보다 작은 원소가 없습니다.
+func pdqSortHelper[T cmp.Ordered](arr []T, low, high, limit int) {
+	wasBalanced, wasPartitioned := true, true
+
+	for {
+		size := high - low + 1
+		if size <= pdqInsertionCutoff {
+			insertionSort(arr, low, high)
+			return
+		}
+
+		if limit == 0 {
+			heapSort(arr, low, high)
+			return
+		}
+
+		// 직전 분할이 치우쳤으면 패턴을 깨고 예산 차감
+		if !wasBalanced {
+			breakPatterns(arr, low, high)
+			limit--
+		}
+
+		pdqChoosePivot(arr, low, high)
+
+		// 피벗과 같은 원소가 많음 - 피벗 이하를 앞으로 모으고 큰 쪽만 계속
+		if low > 0 && !(arr[low-1] < arr[low]) {
+			low = partitionEqual(arr, low, high)
+			continue
+		}
+
+		// 직전 분할이 균형 잡혔고 교환이 없었다면 거의 정렬된 구간일 가능성이 큼
+		if wasBalanced && wasPartitioned && partialInsertionSort(arr, low, high) {
+			return
+		}
+
+		mid, alreadyPartitioned := partitionBlock(arr, low, high)
+		wasPartitioned = alreadyPartitioned
+
+		// 작은 쪽을 재귀로, 큰 쪽은 반복으로
+		leftLen, rightLen := mid-low, high-mid
+		if leftLen < rightLen {
+			wasBalanced = leftLen >= size/8
+			pdqSortHelper(arr, low, mid-1, limit)
+			low = mid + 1
+		} else {
+			wasBalanced = rightLen >= size/8
+			pdqSortHelper(arr, mid+1, high, limit)
+			high = mid - 1
+		}
+	}
+}
+
+// parallelPdqSortHelper pdqSortHelper 와 같은 패턴 회피 루프에서 작은 쪽을 태스크로 넘기는 병렬 pdqsort
+// 임계값 이하 구간은 pdqSortHelper 로 순차 정렬하며, limit 과 패턴 깨기, 부분 삽입정렬 시도는
+// pdqSortHelper 와 같습니다. arr[low-1] 은 이미 자리가 확정된 피벗이므로 다른 태스크가 건드리지 않습니다.
+func parallelPdqSortHelper[T cmp.Ordered](w *Worker, g *TaskGroup, arr []T, low, high, limit int, t *Tuning) {
+	threshold := t.Threshold(len(arr))
+	wasBalanced, wasPartitioned := true, true
+
+	for low < high && !w.aborted() {
+		size := high - low + 1
+		if size <= threshold {
+			pdqSortHelper(arr, low, high, limit)
+			return
+		}
+
+		if limit == 0 {
+			heapSort(arr, low, high)
+			return
+		}
+
+		// 직전 분할이 치우쳤으면 패턴을 깨고 예산 차감
+		if !wasBalanced {
+			breakPatterns(arr, low, high)
+			limit--
+		}
+
+		pdqChoosePivot(arr, low, high)
+
+		// 피벗과 같은 원소가 많음 - 피벗 이하를 앞으로 모으고 큰 쪽만 계속
+		if low > 0 && !(arr[low-1] < arr[low]) {
+			low = partitionEqual(arr, low, high)
+			continue
+		}
+
+		// 직전 분할이 균형 잡혔고 교환이 없었다면 거의 정렬된 구간일 가능성이 큼
+		if wasBalanced && wasPartitioned && partialInsertionSort(arr, low, high) {
+			return
+		}
+
+		mid, alreadyPartitioned := partitionBlock(arr, low, high)
+		wasPartitioned = alreadyPartitioned
+
+		// 작은 쪽은 태스크로 넘기고 큰 쪽은 직접 계속 처리
+		leftLen, rightLen := mid-low, high-mid
+		if leftLen < rightLen {
+			wasBalanced = leftLen >= size/8
+			spawnPdqSort(w, g, arr, low, mid-1, threshold, limit, t)
+			low = mid + 1
+		} else {
+			wasBalanced = rightLen >= size/8
+			spawnPdqSort(w, g, arr, mid+1, high, threshold, limit, t)
+			high = mid - 1
+		}
+	}
+}
+
+// spawnPdqSort 부분 구간을 태스크로 등록 (임계값 이하이면 바로 순차 정렬)
+func spawnPdqSort[T cmp.Ordered](w *Worker, g *TaskGroup, arr []T, low, high, threshold, limit int, t *Tuning) {
+	if low >= high {
+		return
+	}
+	if high-low+1 <= threshold {
+		pdqSortHelper(arr, low, high, limit)
+		return
+	}
+	w.Spawn(g, func(w *Worker) {
+		parallelPdqSortHelper(w, g, arr, low, high, limit, t)
+	})
+}
+
+// pdqChoosePivot 피벗을 골라 arr[low] 로 옮김
+// 큰 구간은 세 곳의 세 원소 중앙값들의 중앙값(Tukey ninther)을 사용합니다.
+func pdqChoosePivot[T cmp.Ordered](arr []T, low, high int) {
+	size := high - low + 1
+	a, b, c := low+size/4, low+size/2, low+size/4*3
+	if size >= pdqNintherCutoff {
+		sort3(arr, a-1, a, a+1)
+		sort3(arr, b-1, b, b+1)
+		sort3(arr, c-1, c, c+1)
+	}
+	sort3(arr, a, b, c)
+	arr[low], arr[b] = arr[b], arr[low]
+}
+
+// sort3 arr[a] <= arr[b] <= arr[c] 가 되도록 세 원소 정렬
+func sort3[T cmp.Ordered](arr []T, a, b, c int) {
+	if arr[b] < arr[a] {
+		arr[a], arr[b] = arr[b], arr[a]
+	}
+	if arr[c] < arr[b] {
+		arr[b], arr[c] = arr[c], arr[b]
+		if arr[b] < arr[a] {
+			arr[a], arr[b] = arr[b], arr[a]
+		}
+	}
+}
+
+// partitionBlock arr[low] 를 피벗으로 arr[low..high] 를 블록 단위로 분할
+// 양쪽 끝에서 pdqBlockSize 개씩 잘못된 위치의 원소 오프셋을 분기 없이 모은 뒤 한꺼번에 교환하므로
+// 비교 결과가 무작위여도 분기 예측 실패가 거의 없습니다. 남은 짧은 구간은 Hoare 방식으로 마무리합니다.
+// 피벗의 최종 위치와, 교환 없이 이미 분할되어 있었는지를 반환합니다.
+func partitionBlock[T cmp.Ordered](arr []T, low, high int) (int, bool) {
+	pivot := arr[low]
+	l, r := low+1, high
+
+	// 이미 제자리인 양 끝은 건너뜀
+	for l <= r && arr[l] < pivot {
+		l++
+	}
+	for l <= r && !(arr[r] < pivot) {
+		r--
+	}
+	if l > r {
+		arr[low], arr[r] = arr[r], arr[low]
+		return r, true
+	}
+	arr[l], arr[r] = arr[r], arr[l]
+	l++
+	r--
+
+	// 불변식: arr[low+1..l-1] < pivot, arr[r+1..high] >= pivot
+	var offsetsL, offsetsR [pdqBlockSize]uint8
+	var startL, numL, startR, numR int
+	for r-l+1 > 2*pdqBlockSize {
+		if numL == 0 {
+			startL = 0
+			for i := range pdqBlockSize {
+				offsetsL[numL] = uint8(i)
+				numL += b2i(!(arr[l+i] < pivot))
+			}
+		}
+		if numR == 0 {
+			startR = 0
+			for i := range pdqBlockSize {
+				offsetsR[numR] = uint8(i)
+				numR += b2i(arr[r-i] < pivot)
+			}
+		}
+
+		num := min(numL, numR)
+		for k := range num {
+			i, j := l+int(offsetsL[startL+k]), r-int(offsetsR[startR+k])
+			arr[i], arr[j] = arr[j], arr[i]
+		}
+		numL -= num
+		numR -= num
+		startL += num
+		startR += num
+
+		// 다 처리한 블록만 넘김 (남은 블록은 다음 단계나 마무리에서 다시 검사)
+		if numL == 0 {
+			l += pdqBlockSize
+		}
+		if numR == 0 {
+			r -= pdqBlockSize
+		}
+	}
+
+	for {
+		for l <= r && arr[l] < pivot {
+			l++
+		}
+		for l <= r && !(arr[r] < pivot) {
+			r--
+		}
+		if l > r {
+			break
+		}
+		arr[l], arr[r] = arr[r], arr[l]
+		l++
+		r--
+	}
+
+	arr[low], arr[r] = arr[r], arr[low]
+	return r, false
+}
+
+// b2i 분기 없는 bool → int 변환 (컴파일러가 SETcc 로 바꿈)
+func b2i(b bool) int {
+	var i int
+	if b {
+		i = 1
+	}
+	return i
+}
+
+// partitionEqual arr[low] 와 같은 원소를 앞으로 모음 (피벗보다 작은 원소가 없는 구간용)
+// 피벗보다 큰 첫 원소의 위치를 반환합니다.
+func partitionEqual[T cmp.Ordered](arr []T, low, high int) int {
+	pivot := arr[low]
+	i, j := low+1, high
+	for {
+		for i <= j && !(pivot < arr[i]) {
+			i++
+		}
+		for i <= j && pivot < arr[j] {
+			j--
+		}
+		if i > j {
+			return i
+		}
+		arr[i], arr[j] = arr[j], arr[i]
+		i++
+		j--
+	}
+}
+
+// partialInsertionSort 역전이 몇 개뿐이면 삽입정렬로 마저 정렬하고 true
+// 역전을 pdqPartialSteps 개 넘게 만나면 고친 부분까지만 두고 false 를 반환합니다.
+func partialInsertionSort[T cmp.Ordered](arr []T, low, high int) bool {
+	i := low + 1
+	for range pdqPartialSteps {
+		for i <= high && !(arr[i] < arr[i-1]) {
+			i++
+		}
+		if i > high {
+			return true
+		}
+		if high-low+1 < pdqPartialMinSize {
+			return false
+		}
+		arr[i], arr[i-1] = arr[i-1], arr[i]
+
+		// 작은 쪽은 왼쪽으로, 큰 쪽은 오른쪽으로 밀어 넣음
+		for j := i - 1; j > low && arr[j] < arr[j-1]; j-- {
+			arr[j], arr[j-1] = arr[j-1], arr[j]
+		}
+		for j := i + 1; j <= high && arr[j] < arr[j-1]; j++ {
+			arr[j], arr[j-1] = arr[j-1], arr[j]
+		}
+	}
+	return false
+}
+
+// breakPatterns 분할이 치우친 구간의 가운데 근처 세 원소를 무작위 위치와 교환
+// 피벗 선택을 반복해서 속이는 입력 패턴을 깨뜨립니다.
+func breakPatterns[T any](arr []T, low, high int) {
+	size := high - low + 1
+	if size < 8 {
+		return
+	}
+	rng := sampleSeed(size)
+	idx := low + size/4*2 - 1
+	for i := range 3 {
+		other := low + sampleIndex(&rng, size)
+		arr[idx-1+i], arr[other] = arr[other], arr[idx-1+i]
+	}
+}
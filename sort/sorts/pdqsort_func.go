@@ -0,0 +1,287 @@
+package sorts
+
+import "math/bits"
+
+// PdqSortFunc 비교 함수 기반 패턴 회피 퀵소트
+func PdqSortFunc[T any](arr []T, cmp func(a, b T) int) {
+	if len(arr) < 2 {
+		return
+	}
+	pdqSortHelperFunc(arr, 0, len(arr)-1, bits.Len(uint(len(arr))), cmp)
+}
+
+// ParallelPdqSortFunc 비교 함수 기반 병렬 패턴 회피 퀵소트
+func ParallelPdqSortFunc[T any](arr []T, cmp func(a, b T) int) {
+	if len(arr) < 2 {
+		return
+	}
+
+	DefaultScheduler().Run(func(w *Worker) {
+		var g TaskGroup
+		parallelPdqSortHelperFunc(w, &g, arr, 0, len(arr)-1, bits.Len(uint(len(arr))), currentTuning(), cmp)
+		w.Wait(&g)
+	})
+}
+
+// pdqSortHelperFunc pdqsort 본체 (비교 함수 버전)
+func pdqSortHelperFunc[T any](arr []T, low, high, limit int, cmp func(a, b T) int) {
+	wasBalanced, wasPartitioned := true, true
+
+	for {
+		size := high - low + 1
+		if size <= pdqInsertionCutoff {
+			insertionSortFunc(arr, low, high, cmp)
+			return
+		}
+
+		if limit == 0 {
+			heapSortFunc(arr, low, high, cmp)
+			return
+		}
+
+		// 직전 분할이 치우쳤으면 패턴을 깨고 예산 차감
+		if !wasBalanced {
+			breakPatterns(arr, low, high)
+			limit--
+		}
+
+		pdqChoosePivotFunc(arr, low, high, cmp)
+
+		// 피벗과 같은 원소가 많음 - 피벗 이하를 앞으로 모으고 큰 쪽만 계속
+		if low > 0 && cmp(arr[low-1], arr[low]) >= 0 {
+			low = partitionEqualFunc(arr, low, high, cmp)
+			continue
+		}
+
+		// 직전 분할이 균형 잡혔고 교환이 없었다면 거의 정렬된 구간일 가능성이 큼
+		if wasBalanced && wasPartitioned && partialInsertionSortFunc(arr, low, high, cmp) {
+			return
+		}
+
+		mid, alreadyPartitioned := partitionBlockFunc(arr, low, high, cmp)
+		wasPartitioned = alreadyPartitioned
+
+		// 작은 쪽을 재귀로, 큰 쪽은 반복으로
+		leftLen, rightLen := mid-low, high-mid
+		if leftLen < rightLen {
+			wasBalanced = leftLen >= size/8
+			pdqSortHelperFunc(arr, low, mid-1, limit, cmp)
+			low = mid + 1
+		} else {
+			wasBalanced = rightLen >= size/8
+			pdqSortHelperFunc(arr, mid+1, high, limit, cmp)
+			high = mid - 1
+		}
+	}
+}
+
+// parallelPdqSortHelperFunc 병렬 pdqsort 본체 (비교 함수 버전)
+func parallelPdqSortHelperFunc[T any](w *Worker, g *TaskGroup, arr []T, low, high, limit int, t *Tuning, cmp func(a, b T) int) {
+	threshold := t.Threshold(len(arr))
+	wasBalanced, wasPartitioned := true, true
+
+	for low < high && !w.aborted() {
+		size := high - low + 1
+		if size <= threshold {
+			pdqSortHelperFunc(arr, low, high, limit, cmp)
+			return
+		}
+
+		if limit == 0 {
+			heapSortFunc(arr, low, high, cmp)
+			return
+		}
+
+		if !wasBalanced {
+			breakPatterns(arr, low, high)
+			limit--
+		}
+
+		pdqChoosePivotFunc(arr, low, high, cmp)
+
+		if low > 0 && cmp(arr[low-1], arr[low]) >= 0 {
+			low = partitionEqualFunc(arr, low, high, cmp)
+			continue
+		}
+
+		if wasBalanced && wasPartitioned && partialInsertionSortFunc(arr, low, high, cmp) {
+			return
+		}
+
+		mid, alreadyPartitioned := partitionBlockFunc(arr, low, high, cmp)
+		wasPartitioned = alreadyPartitioned
+
+		leftLen, rightLen := mid-low, high-mid
+		if leftLen < rightLen {
+			wasBalanced = leftLen >= size/8
+			spawnPdqSortFunc(w, g, arr, low, mid-1, threshold, limit, t, cmp)
+			low = mid + 1
+		} else {
+			wasBalanced = rightLen >= size/8
+			spawnPdqSortFunc(w, g, arr, mid+1, high, threshold, limit, t, cmp)
+			high = mid - 1
+		}
+	}
+}
+
+func spawnPdqSortFunc[T any](w *Worker, g *TaskGroup, arr []T, low, high, threshold, limit int, t *Tuning, cmp func(a, b T) int) {
+	if low >= high {
+		return
+	}
+	if high-low+1 <= threshold {
+		pdqSortHelperFunc(arr, low, high, limit, cmp)
+		return
+	}
+	w.Spawn(g, func(w *Worker) {
+		parallelPdqSortHelperFunc(w, g, arr, low, high, limit, t, cmp)
+	})
+}
+
+// pdqChoosePivotFunc 피벗 선택 (비교 함수 버전)
+func pdqChoosePivotFunc[T any](arr []T, low, high int, cmp func(a, b T) int) {
+	size := high - low + 1
+	a, b, c := low+size/4, low+size/2, low+size/4*3
+	if size >= pdqNintherCutoff {
+		sort3Func(arr, a-1, a, a+1, cmp)
+		sort3Func(arr, b-1, b, b+1, cmp)
+		sort3Func(arr, c-1, c, c+1, cmp)
+	}
+	sort3Func(arr, a, b, c, cmp)
+	arr[low], arr[b] = arr[b], arr[low]
+}
+
+// sort3Func 세 원소 정렬 (비교 함수 버전)
+func sort3Func[T any](arr []T, a, b, c int, cmp func(a, b T) int) {
+	if cmp(arr[b], arr[a]) < 0 {
+		arr[a], arr[b] = arr[b], arr[a]
+	}
+	if cmp(arr[c], arr[b]) < 0 {
+		arr[b], arr[c] = arr[c], arr[b]
+		if cmp(arr[b], arr[a]) < 0 {
+			arr[a], arr[b] = arr[b], arr[a]
+		}
+	}
+}
+
+// partitionBlockFunc 블록 분할 (비교 함수 버전)
+func partitionBlockFunc[T any](arr []T, low, high int, cmp func(a, b T) int) (int, bool) {
+	pivot := arr[low]
+	l, r := low+1, high
+
+	// 이미 제자리인 양 끝은 건너뜀
+	for l <= r && cmp(arr[l], pivot) < 0 {
+		l++
+	}
+	for l <= r && cmp(arr[r], pivot) >= 0 {
+		r--
+	}
+	if l > r {
+		arr[low], arr[r] = arr[r], arr[low]
+		return r, true
+	}
+	arr[l], arr[r] = arr[r], arr[l]
+	l++
+	r--
+
+	// 불변식: arr[low+1..l-1] < pivot, arr[r+1..high] >= pivot
+	var offsetsL, offsetsR [pdqBlockSize]uint8
+	var startL, numL, startR, numR int
+	for r-l+1 > 2*pdqBlockSize {
+		if numL == 0 {
+			startL = 0
+			for i := range pdqBlockSize {
+				offsetsL[numL] = uint8(i)
+				numL += b2i(cmp(arr[l+i], pivot) >= 0)
+			}
+		}
+		if numR == 0 {
+			startR = 0
+			for i := range pdqBlockSize {
+				offsetsR[numR] = uint8(i)
+				numR += b2i(cmp(arr[r-i], pivot) < 0)
+			}
+		}
+
+		num := min(numL, numR)
+		for k := range num {
+			i, j := l+int(offsetsL[startL+k]), r-int(offsetsR[startR+k])
+			arr[i], arr[j] = arr[j], arr[i]
+		}
+		numL -= num
+		numR -= num
+		startL += num
+		startR += num
+
+		// 다 처리한 블록만 넘김 (남은 블록은 다음 단계나 마무리에서 다시 검사)
+		if numL == 0 {
+			l += pdqBlockSize
+		}
+		if numR == 0 {
+			r -= pdqBlockSize
+		}
+	}
+
+	for {
+		for l <= r && cmp(arr[l], pivot) < 0 {
+			l++
+		}
+		for l <= r && cmp(arr[r], pivot) >= 0 {
+			r--
+		}
+		if l > r {
+			break
+		}
+		arr[l], arr[r] = arr[r], arr[l]
+		l++
+		r--
+	}
+
+	arr[low], arr[r] = arr[r], arr[low]
+	return r, false
+}
+
+// partitionEqualFunc 피벗과 같은 원소를 앞으로 모음 (비교 함수 버전)
+func partitionEqualFunc[T any](arr []T, low, high int, cmp func(a, b T) int) int {
+	pivot := arr[low]
+	i, j := low+1, high
+	for {
+		for i <= j && cmp(pivot, arr[i]) >= 0 {
+			i++
+		}
+		for i <= j && cmp(pivot, arr[j]) < 0 {
+			j--
+		}
+		if i > j {
+			return i
+		}
+		arr[i], arr[j] = arr[j], arr[i]
+		i++
+		j--
+	}
+}
+
+// partialInsertionSortFunc 부분 삽입정렬 (비교 함수 버전)
+func partialInsertionSortFunc[T any](arr []T, low, high int, cmp func(a, b T) int) bool {
+	i := low + 1
+	for range pdqPartialSteps {
+		for i <= high && cmp(arr[i], arr[i-1]) >= 0 {
+			i++
+		}
+		if i > high {
+			return true
+		}
+		if high-low+1 < pdqPartialMinSize {
+			return false
+		}
+		arr[i], arr[i-1] = arr[i-1], arr[i]
+
+		// 작은 쪽은 왼쪽으로, 큰 쪽은 오른쪽으로 밀어 넣음
+		for j := i - 1; j > low && cmp(arr[j], arr[j-1]) < 0; j-- {
+			arr[j], arr[j-1] = arr[j-1], arr[j]
+		}
+		for j := i + 1; j <= high && cmp(arr[j], arr[j-1]) < 0; j++ {
+			arr[j], arr[j-1] = arr[j-1], arr[j]
+		}
+	}
+	return false
+}
@@ -0,0 +1,99 @@
+package sorts
+
+import "cmp"
+
+// 병렬 병합 설정
+const (
+	parallelMergeThreshold = 1 << 14 // 이보다 작은 병합은 순차 처리
+	mergeSegmentMin        = 4096    // 병렬 병합 구간당 최소 출력 원소 수
+)
+
+// parallelMerge 정렬된 left, right 를 병합한 새 슬라이스 반환
+// 크기가 작으면 순차 merge 를, 크면 merge path 병렬 병합을 사용합니다.
+func parallelMerge[T cmp.Ordered](w *Worker, left, right []T) []T {
+	if len(left)+len(right) < parallelMergeThreshold {
+		return merge(left, right)
+	}
+	dst := make([]T, len(left)+len(right))
+	parallelMergeInto(w, left, right, dst)
+	return dst
+}
+
+// parallelMergeInto 정렬된 left, right 를 dst 에 병렬 병합 (merge path)
+// 출력을 같은 크기의 구간으로 나누고, 각 구간 경계(대각선)에서 left 와 right 를
+// 어디까지 썼는지 이진 탐색으로 구해 구간들을 서로 독립적으로 병합합니다.
+// 같은 값은 left 가 먼저 오므로 안정 병합입니다.
+func parallelMergeInto[T cmp.Ordered](w *Worker, left, right, dst []T) {
+	n := len(dst)
+	segments := mergeSegmentCount(w, n)
+	if n < parallelMergeThreshold || segments < 2 {
+		mergeInto(left, right, dst)
+		return
+	}
+
+	parallelFor(w, segments, func(_ *Worker, s int) {
+		lo, hi := chunkBounds(n, segments, s)
+		i0, i1 := mergePath(left, right, lo), mergePath(left, right, hi)
+		mergeInto(left[i0:i1], right[lo-i0:hi-i1], dst[lo:hi])
+	})
+}
+
+// mergePath 병합 결과의 앞 diag 개에 포함되는 left 원소 수
+// left[i] <= right[diag-1-i] 이면 left[i] 가 먼저 나오므로 i 는 더 커야 합니다 (단조성).
+func mergePath[T cmp.Ordered](left, right []T, diag int) int {
+	lo, hi := max(0, diag-len(right)), min(diag, len(left))
+	for lo < hi {
+		mid := int(uint(lo+hi) >> 1)
+		if left[mid] <= right[diag-1-mid] {
+			lo = mid + 1
+		} else {
+			hi = mid
+		}
+	}
+	return lo
+}
+
+// mergeSegmentCount 병렬 병합 구간 수 (워커당 여러 구간을 두어 훔치기로 균형을 맞춤)
+func mergeSegmentCount(w *Worker, n int) int {
+	return max(1, min(4*w.s.NumWorkers(), n/mergeSegmentMin))
+}
+
+// parallelMergeFunc 병렬 병합 (비교 함수 버전)
+func parallelMergeFunc[T any](w *Worker, left, right []T, cmp func(a, b T) int) []T {
+	if len(left)+len(right) < parallelMergeThreshold {
+		return mergeFunc(left, right, cmp)
+	}
+	dst := make([]T, len(left)+len(right))
+	parallelMergeIntoFunc(w, left, right, dst, cmp)
+	return dst
+}
+
+// parallelMergeIntoFunc merge path 병렬 병합 (비교 함수 버전)
+func parallelMergeIntoFunc[T any](w *Worker, left, right, dst []T, cmp func(a, b T) int) {
+	n := len(dst)
+	segments := mergeSegmentCount(w, n)
+	if n < parallelMergeThreshold || segments < 2 {
+		mergeIntoFunc(left, right, dst, cmp)
+		return
+	}
+
+	parallelFor(w, segments, func(_ *Worker, s int) {
+		lo, hi := chunkBounds(n, segments, s)
+		i0, i1 := mergePathFunc(left, right, lo, cmp), mergePathFunc(left, right, hi, cmp)
+		mergeIntoFunc(left[i0:i1], right[lo-i0:hi-i1], dst[lo:hi], cmp)
+	})
+}
+
+// mergePathFunc merge path 대각선 탐색 (비교 함수 버전)
+func mergePathFunc[T any](left, right []T, diag int, cmp func(a, b T) int) int {
+	lo, hi := max(0, diag-len(right)), min(diag, len(left))
+	for lo < hi {
+		mid := int(uint(lo+hi) >> 1)
+		if cmp(left[mid], right[diag-1-mid]) <= 0 {
+			lo = mid + 1
+		} else {
+			hi = mid
+		}
+	}
+	return lo
+}
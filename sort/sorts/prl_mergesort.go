@@ -0,0 +1,96 @@
+package sorts
+
+import (
+	"cmp"
+	"context"
+)
+
+// ParallelMergeSort 워크 스틸링 스케줄러 기반 병렬 머지소트
+func ParallelMergeSort[T cmp.Ordered](arr []T) []T {
+	var result []T
+	DefaultScheduler().Run(func(w *Worker) {
+		result = parallelMergeSortHelper(w, arr, len(arr), currentTuning())
+	})
+	return result
+}
+
+// ParallelMergeSortContext ctx 가 취소되면 정렬을 중단하는 병렬 머지소트
+// 중단되면 nil 과 ctx.Err() 를 반환합니다 (arr 는 바뀌지 않음).
+// 태스크에서 패닉이 나면 *PanicError 를 반환합니다.
+func ParallelMergeSortContext[T cmp.Ordered](ctx context.Context, arr []T) ([]T, error) {
+	var result []T
+	err := DefaultScheduler().RunContext(ctx, func(w *Worker) {
+		result = parallelMergeSortHelper(w, arr, len(arr), currentTuning())
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+func parallelMergeSortHelper[T cmp.Ordered](w *Worker, arr []T, totalSize int, t *Tuning) []T {
+	if len(arr) <= 1 || w.aborted() {
+		return arr
+	}
+
+	// 동적 임계값 사용
+	threshold := t.Threshold(totalSize)
+
+	if len(arr) < threshold {
+		return mergeSort(arr, t)
+	}
+
+	mid := len(arr) / 2
+	var left, right []T
+
+	// 왼쪽 절반은 태스크로 넘기고 오른쪽 절반은 직접 처리
+	var g TaskGroup
+	w.Spawn(&g, func(w *Worker) {
+		left = parallelMergeSortHelper(w, arr[:mid], totalSize, t)
+	})
+	right = parallelMergeSortHelper(w, arr[mid:], totalSize, t)
+
+	// 왼쪽이 끝날 때까지 다른 태스크를 도우며 대기
+	w.Wait(&g)
+
+	// 큰 병합(특히 최상위)은 merge path 로 나눠 병렬 병합
+	return parallelMerge(w, left, right)
+}
+
+// ✅ 추가: 워커 풀 상태 확인 함수 (디버깅용)
+func WorkerPoolStatus() (used int, capacity int) {
+	pool := loadWorkerPool()
+	return len(pool), cap(pool)
+}
+
+// ResetWorkerPool 예전에는 패닉 뒤 남은 슬롯을 비웠지만, 다른 고루틴이 아직 쥐고 있는
+// 슬롯까지 비워 버려 개수가 어긋날 수 있었습니다. 이제 runChunks 가 패닉 때도 슬롯을
+// 반환하므로 아무 일도 하지 않습니다.
+//
+// Deprecated: 정리할 필요가 없습니다.
+func ResetWorkerPool() {}
+
+// SafeParallelQuickSort 병렬 정렬 중 패닉이 나면 순차 퀵소트로 다시 정렬
+// 태스크의 패닉은 모든 태스크가 멈춘 뒤 호출한 고루틴으로 전달되고,
+// 퀵소트는 원소를 교환만 하므로 arr 는 여전히 입력의 순열입니다.
+func SafeParallelQuickSort[T cmp.Ordered](arr []T) {
+	defer func() {
+		if r := recover(); r != nil {
+			QuickSort(arr)
+		}
+	}()
+
+	ParallelQuickSort(arr)
+}
+
+// SafeParallelMergeSort 병렬 정렬 중 패닉이 나면 순차 머지소트 결과를 반환
+// 병렬 머지소트는 arr 를 바꾸지 않으므로 그대로 다시 정렬할 수 있습니다.
+func SafeParallelMergeSort[T cmp.Ordered](arr []T) (result []T) {
+	defer func() {
+		if r := recover(); r != nil {
+			result = MergeSort(arr)
+		}
+	}()
+
+	return ParallelMergeSort(arr)
+}
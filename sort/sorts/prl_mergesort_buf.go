@@ -0,0 +1,38 @@
+package sorts
+
+import "cmp"
+
+// ParallelMergeSortBuffered 보조 버퍼 하나만 쓰는 병렬 머지소트 (제자리 결과)
+func ParallelMergeSortBuffered[T cmp.Ordered](arr []T) {
+	if len(arr) <= 1 {
+		return
+	}
+
+	buf := make([]T, len(arr))
+	copy(buf, arr)
+	DefaultScheduler().Run(func(w *Worker) {
+		parallelMergeSortPingPong(w, buf, arr, len(arr), currentTuning())
+	})
+}
+
+func parallelMergeSortPingPong[T cmp.Ordered](w *Worker, src, dst []T, totalSize int, t *Tuning) {
+	// 동적 임계값 사용
+	threshold := t.Threshold(totalSize)
+
+	if len(dst) <= threshold {
+		mergeSortPingPong(src, dst, t)
+		return
+	}
+
+	mid := len(dst) / 2
+
+	// 왼쪽 절반은 태스크로, 오른쪽 절반은 직접 src 쪽으로 정렬
+	var g TaskGroup
+	w.Spawn(&g, func(w *Worker) {
+		parallelMergeSortPingPong(w, dst[:mid], src[:mid], totalSize, t)
+	})
+	parallelMergeSortPingPong(w, dst[mid:], src[mid:], totalSize, t)
+
+	w.Wait(&g)
+	parallelMergeInto(w, src[:mid], src[mid:], dst)
+}
@@ -0,0 +1,34 @@
+package sorts
+
+// ParallelMergeSortFunc 비교 함수 기반 병렬 머지소트 (안정 정렬)
+func ParallelMergeSortFunc[T any](arr []T, cmp func(a, b T) int) []T {
+	var result []T
+	DefaultScheduler().Run(func(w *Worker) {
+		result = parallelMergeSortHelperFunc(w, arr, len(arr), currentTuning(), cmp)
+	})
+	return result
+}
+
+func parallelMergeSortHelperFunc[T any](w *Worker, arr []T, totalSize int, t *Tuning, cmp func(a, b T) int) []T {
+	if len(arr) <= 1 {
+		return arr
+	}
+
+	threshold := t.Threshold(totalSize)
+
+	if len(arr) < threshold {
+		return mergeSortFunc(arr, t, cmp)
+	}
+
+	mid := len(arr) / 2
+	var left, right []T
+
+	var g TaskGroup
+	w.Spawn(&g, func(w *Worker) {
+		left = parallelMergeSortHelperFunc(w, arr[:mid], totalSize, t, cmp)
+	})
+	right = parallelMergeSortHelperFunc(w, arr[mid:], totalSize, t, cmp)
+
+	w.Wait(&g)
+	return parallelMergeFunc(w, left, right, cmp)
+}
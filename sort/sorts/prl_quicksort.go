@@ -0,0 +1,77 @@
+package sorts
+
+import (
+	"cmp"
+	"context"
+)
+
+// ParallelQuickSort 워크 스틸링 스케줄러 기반 병렬 퀵소트
+// 분할된 부분 구간을 태스크로 스케줄러 덱에 넣고, 놀고 있는 워커가 훔쳐가 처리합니다.
+func ParallelQuickSort[T cmp.Ordered](arr []T) {
+	if len(arr) < 2 {
+		return
+	}
+
+	DefaultScheduler().Run(func(w *Worker) {
+		var g TaskGroup
+		parallelQuickSortHelper(w, &g, arr, 0, len(arr)-1, noDepthLimit, currentTuning())
+		w.Wait(&g)
+	})
+}
+
+// ParallelQuickSortContext ctx 가 취소되면 정렬을 중단하는 병렬 퀵소트
+// 중단되면 ctx.Err() 를 반환하며, arr 는 원소는 그대로인 채 일부만 정렬된 상태로 남습니다.
+// 태스크에서 패닉이 나면 *PanicError 를 반환합니다.
+func ParallelQuickSortContext[T cmp.Ordered](ctx context.Context, arr []T) error {
+	if len(arr) < 2 {
+		return ctx.Err()
+	}
+
+	return DefaultScheduler().RunContext(ctx, func(w *Worker) {
+		var g TaskGroup
+		parallelQuickSortHelper(w, &g, arr, 0, len(arr)-1, noDepthLimit, currentTuning())
+		w.Wait(&g)
+	})
+}
+
+// parallelQuickSortHelper depthLimit 의 의미는 quickSortHelper 와 같으며,
+// 태스크로 넘기는 부분 구간도 남은 깊이 예산을 그대로 이어받습니다.
+func parallelQuickSortHelper[T cmp.Ordered](w *Worker, g *TaskGroup, arr []T, low, high, depthLimit int, t *Tuning) {
+	// 동적 임계값 계산
+	threshold := t.Threshold(len(arr))
+
+	for low < high && !w.aborted() {
+		if high-low+1 <= threshold || depthLimit == 0 {
+			quickSortHelper(arr, low, high, depthLimit, t)
+			return
+		}
+		depthLimit--
+
+		// 3-way 파티셔닝 사용
+		lt, gt := partition3Way(arr, low, high)
+
+		// 작은 쪽은 태스크로 넘기고 (다른 워커가 훔쳐갈 수 있음) 큰 쪽은 직접 계속 처리
+		if lt-low < high-gt {
+			spawnQuickSort(w, g, arr, low, lt-1, threshold, depthLimit, t)
+			low = gt + 1
+		} else {
+			spawnQuickSort(w, g, arr, gt+1, high, threshold, depthLimit, t)
+			high = lt - 1
+		}
+	}
+}
+
+// spawnQuickSort 부분 구간을 스케줄러 태스크로 등록
+// 임계값 이하의 작은 구간은 태스크 오버헤드가 더 크므로 바로 정렬합니다.
+func spawnQuickSort[T cmp.Ordered](w *Worker, g *TaskGroup, arr []T, low, high, threshold, depthLimit int, t *Tuning) {
+	if low >= high {
+		return
+	}
+	if high-low+1 <= threshold {
+		quickSortHelper(arr, low, high, depthLimit, t)
+		return
+	}
+	w.Spawn(g, func(w *Worker) {
+		parallelQuickSortHelper(w, g, arr, low, high, depthLimit, t)
+	})
+}
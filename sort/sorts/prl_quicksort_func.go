@@ -0,0 +1,49 @@
+package sorts
+
+// ParallelQuickSortFunc 비교 함수 기반 병렬 퀵소트
+func ParallelQuickSortFunc[T any](arr []T, cmp func(a, b T) int) {
+	if len(arr) < 2 {
+		return
+	}
+
+	DefaultScheduler().Run(func(w *Worker) {
+		var g TaskGroup
+		parallelQuickSortHelperFunc(w, &g, arr, 0, len(arr)-1, noDepthLimit, currentTuning(), cmp)
+		w.Wait(&g)
+	})
+}
+
+func parallelQuickSortHelperFunc[T any](w *Worker, g *TaskGroup, arr []T, low, high, depthLimit int, t *Tuning, cmp func(a, b T) int) {
+	threshold := t.Threshold(len(arr))
+
+	for low < high && !w.aborted() {
+		if high-low+1 <= threshold || depthLimit == 0 {
+			quickSortHelperFunc(arr, low, high, depthLimit, t, cmp)
+			return
+		}
+		depthLimit--
+
+		lt, gt := partition3WayFunc(arr, low, high, cmp)
+
+		if lt-low < high-gt {
+			spawnQuickSortFunc(w, g, arr, low, lt-1, threshold, depthLimit, t, cmp)
+			low = gt + 1
+		} else {
+			spawnQuickSortFunc(w, g, arr, gt+1, high, threshold, depthLimit, t, cmp)
+			high = lt - 1
+		}
+	}
+}
+
+func spawnQuickSortFunc[T any](w *Worker, g *TaskGroup, arr []T, low, high, threshold, depthLimit int, t *Tuning, cmp func(a, b T) int) {
+	if low >= high {
+		return
+	}
+	if high-low+1 <= threshold {
+		quickSortHelperFunc(arr, low, high, depthLimit, t, cmp)
+		return
+	}
+	w.Spawn(g, func(w *Worker) {
+		parallelQuickSortHelperFunc(w, g, arr, low, high, depthLimit, t, cmp)
+	})
+}
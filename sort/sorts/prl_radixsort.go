@@ -0,0 +1,181 @@
+package sorts
+
+import (
+	"runtime"
+	"sync"
+	"sync/atomic"
+)
+
+// 병렬 기수정렬을 사용할 최소 크기 (이보다 작으면 순차 기수정렬)
+const parallelRadixThreshold = 8192
+
+// ParallelRadixSort 병렬 LSD 기수정렬 (안정 정렬)
+// 배열을 워커 수만큼 청크로 나눠 청크별 히스토그램을 병렬로 계산하고,
+// (digit, 청크) 순서의 누적합으로 각 청크의 쓰기 위치를 정한 뒤 병렬로 분산합니다.
+func ParallelRadixSort[T Integer](arr []T) {
+	n := len(arr)
+	if n < parallelRadixThreshold {
+		RadixSort(arr)
+		return
+	}
+
+	InitWorkerPool()
+	chunks := radixChunkCount(n)
+	width, signFlip := integerLayout[T]()
+	src, dst := arr, make([]T, n)
+	counts := make([][radixBuckets]int, chunks)
+
+	for pass := range width {
+		shift := uint(pass * 8)
+		flip := byte(0)
+		if pass == width-1 {
+			flip = signFlip
+		}
+
+		// 1) 청크별 히스토그램
+		runChunks(chunks, func(c int) {
+			lo, hi := chunkBounds(n, chunks, c)
+			count := &counts[c]
+			*count = [radixBuckets]int{}
+			for _, v := range src[lo:hi] {
+				count[byte(uint64(v)>>shift)^flip]++
+			}
+		})
+
+		if !radixPrefix(counts, n) {
+			continue // 모든 원소가 같은 digit - 패스 생략
+		}
+
+		// 2) 청크별 분산 (각 청크는 자기 구간에만 쓰므로 충돌 없음)
+		runChunks(chunks, func(c int) {
+			lo, hi := chunkBounds(n, chunks, c)
+			offset := &counts[c]
+			for _, v := range src[lo:hi] {
+				d := byte(uint64(v)>>shift) ^ flip
+				dst[offset[d]] = v
+				offset[d]++
+			}
+		})
+		src, dst = dst, src
+	}
+
+	if &src[0] != &arr[0] {
+		copy(arr, src)
+	}
+}
+
+// ParallelRadixSortBytes 고정 폭 바이트 키 병렬 LSD 기수정렬 (안정 정렬)
+func ParallelRadixSortBytes(keys [][]byte, width int) {
+	n := len(keys)
+	if n < parallelRadixThreshold || width <= 0 {
+		RadixSortBytes(keys, width)
+		return
+	}
+
+	InitWorkerPool()
+	chunks := radixChunkCount(n)
+	src, dst := keys, make([][]byte, n)
+	counts := make([][radixBuckets]int, chunks)
+
+	for pos := width - 1; pos >= 0; pos-- {
+		runChunks(chunks, func(c int) {
+			lo, hi := chunkBounds(n, chunks, c)
+			count := &counts[c]
+			*count = [radixBuckets]int{}
+			for _, k := range src[lo:hi] {
+				count[keyByte(k, pos)]++
+			}
+		})
+
+		if !radixPrefix(counts, n) {
+			continue
+		}
+
+		runChunks(chunks, func(c int) {
+			lo, hi := chunkBounds(n, chunks, c)
+			offset := &counts[c]
+			for _, k := range src[lo:hi] {
+				d := keyByte(k, pos)
+				dst[offset[d]] = k
+				offset[d]++
+			}
+		})
+		src, dst = dst, src
+	}
+
+	if &src[0] != &keys[0] {
+		copy(keys, src)
+	}
+}
+
+// radixChunkCount 병렬 패스에 사용할 청크 수 (워커 풀 크기 기준)
+func radixChunkCount(n int) int {
+	chunks := cap(loadWorkerPool())
+	if chunks < 1 {
+		chunks = runtime.NumCPU()
+	}
+	return max(1, min(chunks, n/radixCutoff))
+}
+
+// chunkBounds c 번째 청크의 [lo, hi) 구간
+func chunkBounds(n, chunks, c int) (int, int) {
+	return c * n / chunks, (c + 1) * n / chunks
+}
+
+// radixPrefix 청크별 히스토그램을 (digit, 청크) 순서의 시작 위치로 바꿉니다.
+// 한 digit 에 모든 원소가 몰려 있으면 (분산이 필요 없으면) false 를 반환합니다.
+func radixPrefix(counts [][radixBuckets]int, n int) bool {
+	sum := 0
+	for d := range radixBuckets {
+		total := 0
+		for c := range counts {
+			total += counts[c][d]
+		}
+		if total == n {
+			return false
+		}
+		for c := range counts {
+			counts[c][d], sum = sum, sum+counts[c][d]
+		}
+	}
+	return true
+}
+
+// runChunks 청크 작업을 워커 풀 슬롯을 얻은 고루틴에서 실행하고 모두 끝날 때까지 대기
+// 슬롯이 없으면 호출한 고루틴에서 순차 처리합니다.
+// 어느 청크에서든 패닉이 나면 모든 고루틴이 슬롯을 반환하고 끝난 뒤 *PanicError 로 다시 panic 합니다.
+func runChunks(chunks int, work func(c int)) {
+	pool := loadWorkerPool()
+	var wg sync.WaitGroup
+	var failure atomic.Pointer[PanicError]
+	run := func(c int) {
+		defer func() {
+			if r := recover(); r != nil {
+				failure.CompareAndSwap(nil, newPanicError(r))
+			}
+		}()
+		if failure.Load() == nil {
+			work(c)
+		}
+	}
+
+	for c := range chunks {
+		select {
+		case pool <- struct{}{}: // 슬롯 획득 시도
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				defer func() { <-pool }() // ✅ 확실히 반환
+				run(c)
+			}()
+		default:
+			// 슬롯 없으면 순차 처리
+			run(c)
+		}
+	}
+
+	wg.Wait()
+	if pe := failure.Load(); pe != nil {
+		panic(pe)
+	}
+}
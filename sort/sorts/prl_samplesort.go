@@ -0,0 +1,176 @@
+package sorts
+
+import (
+	"cmp"
+	"slices"
+)
+
+// 샘플 정렬 설정
+const (
+	DefaultSampleOversampling = 16 // 버킷당 표본 수 기본값
+
+	sampleSortThreshold = 1 << 14   // 이보다 작으면 순차 인트로소트
+	maxSampleSplitters  = 1<<15 - 1 // 버킷 번호(2·분할자 + 1 개)를 uint16 에 담기 위한 상한
+)
+
+// SampleSortOptions 병렬 샘플 정렬 설정
+type SampleSortOptions struct {
+	// Buckets 나눌 버킷 수 (0 이면 스케줄러 워커 수)
+	Buckets int
+	// Oversampling 버킷당 뽑는 표본 수 (0 이면 DefaultSampleOversampling).
+	// 클수록 분할자가 정확해져 버킷 크기가 고르지만 표본 정렬 비용이 늘어납니다.
+	Oversampling int
+}
+
+// resolve 0 으로 둔 설정을 기본값으로 채움
+func (o SampleSortOptions) resolve(s *Scheduler) (buckets, oversampling int) {
+	buckets = o.Buckets
+	if buckets <= 0 {
+		buckets = s.NumWorkers()
+	}
+	buckets = min(buckets, maxSampleSplitters+1)
+
+	oversampling = o.Oversampling
+	if oversampling <= 0 {
+		oversampling = DefaultSampleOversampling
+	}
+	return buckets, oversampling
+}
+
+// ParallelSampleSort 기본 설정의 병렬 샘플 정렬
+func ParallelSampleSort[T cmp.Ordered](arr []T) {
+	ParallelSampleSortWith(arr, SampleSortOptions{})
+}
+
+// ParallelSampleSortWith 병렬 샘플 정렬
+// 최상위 분할이나 최종 병합을 순차로 처리하는 병렬 퀵소트/머지소트와 달리 모든 단계가 병렬입니다.
+//  1. 버킷 수 × Oversampling 개의 표본을 정렬해 p-1 개의 분할자를 고르고
+//  2. 청크별로 원소가 속할 버킷을 병렬로 분류해 세고
+//  3. (버킷, 청크) 순서의 누적합 위치로 보조 배열에 병렬 분산한 뒤
+//  4. 버킷들을 스케줄러 태스크로 동시에 정렬해 원래 배열로 되돌립니다.
+//
+// 분할자와 같은 값은 별도의 '같음 버킷'에 모아 정렬을 생략하므로
+// 중복값이 많아도 한 버킷으로 쏠리지 않습니다.
+func ParallelSampleSortWith[T cmp.Ordered](arr []T, opts SampleSortOptions) {
+	s := DefaultScheduler()
+	buckets, oversampling := opts.resolve(s)
+	if len(arr) < sampleSortThreshold || buckets < 2 {
+		IntroSort(arr)
+		return
+	}
+	parallelSampleSort(s, arr, buckets, oversampling)
+}
+
+func parallelSampleSort[T cmp.Ordered](s *Scheduler, arr []T, buckets, oversampling int) {
+	n := len(arr)
+	if n < 2 {
+		return
+	}
+
+	splitters := chooseSplitters(arr, buckets, oversampling)
+	numBuckets := 2*len(splitters) + 1
+	chunks := sampleChunkCount(n, s)
+
+	oracle := make([]uint16, n) // 원소별 버킷 번호 (분산 단계에서 다시 탐색하지 않도록)
+	counts := make([][]int, chunks)
+	buf := make([]T, n)
+
+	s.Run(func(w *Worker) {
+		// 2) 청크별 분류
+		parallelFor(w, chunks, func(_ *Worker, c int) {
+			lo, hi := chunkBounds(n, chunks, c)
+			count := make([]int, numBuckets)
+			for i := lo; i < hi; i++ {
+				b := sampleBucket(splitters, arr[i])
+				oracle[i] = uint16(b)
+				count[b]++
+			}
+			counts[c] = count
+		})
+
+		// 3) 청크별 분산 (각 청크는 자기 구간에만 쓰므로 충돌 없음)
+		bounds := samplePrefix(counts, numBuckets)
+		parallelFor(w, chunks, func(_ *Worker, c int) {
+			lo, hi := chunkBounds(n, chunks, c)
+			offset := counts[c]
+			for i := lo; i < hi; i++ {
+				b := oracle[i]
+				buf[offset[b]] = arr[i]
+				offset[b]++
+			}
+		})
+
+		// 4) 버킷별 정렬 후 되돌리기 (홀수 번호 '같음 버킷'은 이미 정렬됨)
+		parallelFor(w, numBuckets, func(_ *Worker, b int) {
+			lo, hi := bounds[b], bounds[b+1]
+			if b%2 == 0 {
+				IntroSort(buf[lo:hi])
+			}
+			copy(arr[lo:hi], buf[lo:hi])
+		})
+	})
+}
+
+// chooseSplitters 무작위 표본을 정렬해 buckets-1 개의 분할자를 고름 (중복 제거)
+func chooseSplitters[T cmp.Ordered](arr []T, buckets, oversampling int) []T {
+	sample := make([]T, buckets*oversampling)
+	rng := sampleSeed(len(arr))
+	for i := range sample {
+		sample[i] = arr[sampleIndex(&rng, len(arr))]
+	}
+	IntroSort(sample)
+
+	splitters := make([]T, 0, buckets-1)
+	for i := 1; i < buckets; i++ {
+		v := sample[i*oversampling]
+		if len(splitters) == 0 || splitters[len(splitters)-1] < v {
+			splitters = append(splitters, v)
+		}
+	}
+	return splitters
+}
+
+// sampleBucket v 가 속하는 버킷 번호
+// 분할자 s[j-1] < v < s[j] 이면 2j, v == s[j] 이면 2j+1 ('같음 버킷') 입니다.
+func sampleBucket[T cmp.Ordered](splitters []T, v T) int {
+	j, found := slices.BinarySearch(splitters, v)
+	if found {
+		return 2*j + 1
+	}
+	return 2 * j
+}
+
+// sampleSeed 표본 추출용 xorshift 시드 (같은 입력 크기면 같은 표본 위치)
+func sampleSeed(n int) uint64 {
+	return uint64(n)*0x9E3779B97F4A7C15 | 1
+}
+
+// sampleIndex [0, n) 범위의 다음 무작위 위치
+func sampleIndex(rng *uint64, n int) int {
+	x := *rng
+	x ^= x << 13
+	x ^= x >> 7
+	x ^= x << 17
+	*rng = x
+	return int(x % uint64(n))
+}
+
+// sampleChunkCount 분류/분산 단계의 청크 수 (스케줄러 워커 수 기준)
+func sampleChunkCount(n int, s *Scheduler) int {
+	return max(1, min(s.NumWorkers(), n/radixCutoff))
+}
+
+// samplePrefix 청크별 버킷 개수를 (버킷, 청크) 순서의 쓰기 시작 위치로 바꾸고
+// 버킷 경계 bounds (버킷 b 는 [bounds[b], bounds[b+1])) 를 반환합니다.
+func samplePrefix(counts [][]int, numBuckets int) []int {
+	bounds := make([]int, numBuckets+1)
+	sum := 0
+	for b := range numBuckets {
+		bounds[b] = sum
+		for c := range counts {
+			counts[c][b], sum = sum, sum+counts[c][b]
+		}
+	}
+	bounds[numBuckets] = sum
+	return bounds
+}
@@ -0,0 +1,94 @@
+package sorts
+
+import "slices"
+
+// ParallelSampleSortFunc 비교 함수 기반 병렬 샘플 정렬 (기본 설정)
+func ParallelSampleSortFunc[T any](arr []T, cmp func(a, b T) int) {
+	ParallelSampleSortWithFunc(arr, SampleSortOptions{}, cmp)
+}
+
+// ParallelSampleSortWithFunc 비교 함수 기반 병렬 샘플 정렬
+func ParallelSampleSortWithFunc[T any](arr []T, opts SampleSortOptions, cmp func(a, b T) int) {
+	s := DefaultScheduler()
+	buckets, oversampling := opts.resolve(s)
+	if len(arr) < sampleSortThreshold || buckets < 2 {
+		IntroSortFunc(arr, cmp)
+		return
+	}
+	parallelSampleSortFunc(s, arr, buckets, oversampling, cmp)
+}
+
+func parallelSampleSortFunc[T any](s *Scheduler, arr []T, buckets, oversampling int, cmp func(a, b T) int) {
+	n := len(arr)
+	if n < 2 {
+		return
+	}
+
+	splitters := chooseSplittersFunc(arr, buckets, oversampling, cmp)
+	numBuckets := 2*len(splitters) + 1
+	chunks := sampleChunkCount(n, s)
+
+	oracle := make([]uint16, n)
+	counts := make([][]int, chunks)
+	buf := make([]T, n)
+
+	s.Run(func(w *Worker) {
+		parallelFor(w, chunks, func(_ *Worker, c int) {
+			lo, hi := chunkBounds(n, chunks, c)
+			count := make([]int, numBuckets)
+			for i := lo; i < hi; i++ {
+				b := sampleBucketFunc(splitters, arr[i], cmp)
+				oracle[i] = uint16(b)
+				count[b]++
+			}
+			counts[c] = count
+		})
+
+		bounds := samplePrefix(counts, numBuckets)
+		parallelFor(w, chunks, func(_ *Worker, c int) {
+			lo, hi := chunkBounds(n, chunks, c)
+			offset := counts[c]
+			for i := lo; i < hi; i++ {
+				b := oracle[i]
+				buf[offset[b]] = arr[i]
+				offset[b]++
+			}
+		})
+
+		parallelFor(w, numBuckets, func(_ *Worker, b int) {
+			lo, hi := bounds[b], bounds[b+1]
+			if b%2 == 0 {
+				IntroSortFunc(buf[lo:hi], cmp)
+			}
+			copy(arr[lo:hi], buf[lo:hi])
+		})
+	})
+}
+
+// chooseSplittersFunc 분할자 선택 (비교 함수 버전)
+func chooseSplittersFunc[T any](arr []T, buckets, oversampling int, cmp func(a, b T) int) []T {
+	sample := make([]T, buckets*oversampling)
+	rng := sampleSeed(len(arr))
+	for i := range sample {
+		sample[i] = arr[sampleIndex(&rng, len(arr))]
+	}
+	IntroSortFunc(sample, cmp)
+
+	splitters := make([]T, 0, buckets-1)
+	for i := 1; i < buckets; i++ {
+		v := sample[i*oversampling]
+		if len(splitters) == 0 || cmp(splitters[len(splitters)-1], v) < 0 {
+			splitters = append(splitters, v)
+		}
+	}
+	return splitters
+}
+
+// sampleBucketFunc 버킷 번호 (비교 함수 버전)
+func sampleBucketFunc[T any](splitters []T, v T, cmp func(a, b T) int) int {
+	j, found := slices.BinarySearchFunc(splitters, v, cmp)
+	if found {
+		return 2*j + 1
+	}
+	return 2 * j
+}
@@ -0,0 +1,204 @@
+package sorts
+
+import "cmp"
+
+// 병렬 선택을 사용할 최소 구간 크기 (이보다 작아지면 순차 Select)
+const parallelSelectThreshold = 1 << 15
+
+// 병렬 선택 피벗 표본 수
+const selectSampleSize = 31
+
+// ParallelNthElement 병렬 Select
+// 표본에서 k 의 상대 순위에 가까운 피벗을 고른 뒤, 청크별로 (작음/같음/큼) 개수를 병렬로 세고
+// 보조 배열에 병렬 분산했다가 되돌리는 3-way 분할을 반복합니다. 매 단계 k 가 속한 쪽만 남기고,
+// 남은 구간이 작아지거나 분할 횟수가 2·log2(n) 을 넘으면 순차 Select 로 마무리합니다.
+// 결과는 Select 와 같이 arr[:k] <= arr[k] <= arr[k+1:] 입니다.
+func ParallelNthElement[T cmp.Ordered](arr []T, k int) T {
+	if k < 0 || k >= len(arr) {
+		panic("sorts: ParallelNthElement 인덱스 범위 초과")
+	}
+	s := DefaultScheduler()
+	if len(arr) < parallelSelectThreshold || s.NumWorkers() < 2 {
+		return Select(arr, k)
+	}
+	return parallelNthElement(s, arr, k)
+}
+
+func parallelNthElement[T cmp.Ordered](s *Scheduler, arr []T, k int) T {
+	buf := make([]T, len(arr))
+	low, high := 0, len(arr) // [low, high)
+	rng := sampleSeed(len(arr))
+	depthLimit := introDepthLimit(len(arr))
+
+	s.Run(func(w *Worker) {
+		for high-low >= parallelSelectThreshold && depthLimit > 0 {
+			depthLimit--
+			seg := arr[low:high]
+			pivot := selectPivot(seg, k-low, &rng)
+
+			lt, gt := parallelPartition3(w, seg, buf[low:high], pivot)
+			switch {
+			case k-low < lt:
+				high = low + lt
+			case k-low >= gt:
+				low += gt
+			default:
+				low, high = k, k // 피벗과 같은 구간 - 완료
+			}
+		}
+	})
+
+	if high-low > 1 {
+		Select(arr[low:high], k-low)
+	}
+	return arr[k]
+}
+
+// selectPivot seg 의 표본을 정렬해 순위 k 에 해당하는 위치의 값을 피벗으로 고름
+func selectPivot[T cmp.Ordered](seg []T, k int, rng *uint64) T {
+	var sample [selectSampleSize]T
+	for i := range sample {
+		sample[i] = seg[sampleIndex(rng, len(seg))]
+	}
+	insertionSort(sample[:], 0, selectSampleSize-1)
+	return sample[k*(selectSampleSize-1)/(len(seg)-1)]
+}
+
+// parallelPartition3 seg 를 pivot 기준 (작음, 같음, 큼) 순서로 병렬 3-way 분할
+// buf 는 seg 와 같은 크기의 작업 공간이며, 반환값은 같음 구간의 [lt, gt) 입니다.
+func parallelPartition3[T cmp.Ordered](w *Worker, seg, buf []T, pivot T) (int, int) {
+	n := len(seg)
+	chunks := sampleChunkCount(n, w.s)
+	counts := make([][]int, chunks)
+
+	parallelFor(w, chunks, func(_ *Worker, c int) {
+		lo, hi := chunkBounds(n, chunks, c)
+		count := make([]int, 3)
+		for _, v := range seg[lo:hi] {
+			count[partitionClass(v, pivot)]++
+		}
+		counts[c] = count
+	})
+
+	bounds := samplePrefix(counts, 3)
+	parallelFor(w, chunks, func(_ *Worker, c int) {
+		lo, hi := chunkBounds(n, chunks, c)
+		offset := counts[c]
+		for _, v := range seg[lo:hi] {
+			b := partitionClass(v, pivot)
+			buf[offset[b]] = v
+			offset[b]++
+		}
+	})
+
+	// 분산한 결과를 되돌림
+	parallelFor(w, chunks, func(_ *Worker, c int) {
+		lo, hi := chunkBounds(n, chunks, c)
+		copy(seg[lo:hi], buf[lo:hi])
+	})
+	return bounds[1], bounds[2]
+}
+
+// partitionClass 작음 0, 같음 1, 큼 2
+func partitionClass[T cmp.Ordered](v, pivot T) int {
+	if v < pivot {
+		return 0
+	}
+	if v > pivot {
+		return 2
+	}
+	return 1
+}
+
+// ParallelNthElementFunc 비교 함수 기반 병렬 Select
+func ParallelNthElementFunc[T any](arr []T, k int, cmp func(a, b T) int) T {
+	if k < 0 || k >= len(arr) {
+		panic("sorts: ParallelNthElementFunc 인덱스 범위 초과")
+	}
+	s := DefaultScheduler()
+	if len(arr) < parallelSelectThreshold || s.NumWorkers() < 2 {
+		return SelectFunc(arr, k, cmp)
+	}
+	return parallelNthElementFunc(s, arr, k, cmp)
+}
+
+func parallelNthElementFunc[T any](s *Scheduler, arr []T, k int, cmp func(a, b T) int) T {
+	buf := make([]T, len(arr))
+	low, high := 0, len(arr)
+	rng := sampleSeed(len(arr))
+	depthLimit := introDepthLimit(len(arr))
+
+	s.Run(func(w *Worker) {
+		for high-low >= parallelSelectThreshold && depthLimit > 0 {
+			depthLimit--
+			seg := arr[low:high]
+			pivot := selectPivotFunc(seg, k-low, &rng, cmp)
+
+			lt, gt := parallelPartition3Func(w, seg, buf[low:high], pivot, cmp)
+			switch {
+			case k-low < lt:
+				high = low + lt
+			case k-low >= gt:
+				low += gt
+			default:
+				low, high = k, k
+			}
+		}
+	})
+
+	if high-low > 1 {
+		SelectFunc(arr[low:high], k-low, cmp)
+	}
+	return arr[k]
+}
+
+func selectPivotFunc[T any](seg []T, k int, rng *uint64, cmp func(a, b T) int) T {
+	var sample [selectSampleSize]T
+	for i := range sample {
+		sample[i] = seg[sampleIndex(rng, len(seg))]
+	}
+	insertionSortFunc(sample[:], 0, selectSampleSize-1, cmp)
+	return sample[k*(selectSampleSize-1)/(len(seg)-1)]
+}
+
+func parallelPartition3Func[T any](w *Worker, seg, buf []T, pivot T, cmp func(a, b T) int) (int, int) {
+	n := len(seg)
+	chunks := sampleChunkCount(n, w.s)
+	counts := make([][]int, chunks)
+
+	parallelFor(w, chunks, func(_ *Worker, c int) {
+		lo, hi := chunkBounds(n, chunks, c)
+		count := make([]int, 3)
+		for _, v := range seg[lo:hi] {
+			count[partitionClassFunc(v, pivot, cmp)]++
+		}
+		counts[c] = count
+	})
+
+	bounds := samplePrefix(counts, 3)
+	parallelFor(w, chunks, func(_ *Worker, c int) {
+		lo, hi := chunkBounds(n, chunks, c)
+		offset := counts[c]
+		for _, v := range seg[lo:hi] {
+			b := partitionClassFunc(v, pivot, cmp)
+			buf[offset[b]] = v
+			offset[b]++
+		}
+	})
+
+	parallelFor(w, chunks, func(_ *Worker, c int) {
+		lo, hi := chunkBounds(n, chunks, c)
+		copy(seg[lo:hi], buf[lo:hi])
+	})
+	return bounds[1], bounds[2]
+}
+
+func partitionClassFunc[T any](v, pivot T, cmp func(a, b T) int) int {
+	switch c := cmp(v, pivot); {
+	case c < 0:
+		return 0
+	case c > 0:
+		return 2
+	}
+	return 1
+}
@@ -0,0 +1,97 @@
+package sorts
+
+import "cmp"
+
+// ParallelStableSortFunc 병렬 안정 정렬 (비교 함수 버전, 제자리 결과)
+// ParallelMergeSortBuffered 와 같이 절반씩 태스크로 정렬한 뒤 merge path 로 병렬 병합합니다.
+// merge path 분할도 같은 값은 왼쪽 절반을 먼저 두므로 안정성이 유지됩니다.
+func ParallelStableSortFunc[T any](arr []T, cmp func(a, b T) int) {
+	if len(arr) <= 1 {
+		return
+	}
+
+	buf := make([]T, len(arr))
+	copy(buf, arr)
+	DefaultScheduler().Run(func(w *Worker) {
+		parallelMergeSortPingPongFunc(w, buf, arr, len(arr), currentTuning(), cmp)
+	})
+}
+
+func parallelMergeSortPingPongFunc[T any](w *Worker, src, dst []T, totalSize int, t *Tuning, cmp func(a, b T) int) {
+	threshold := t.Threshold(totalSize)
+
+	if len(dst) <= threshold {
+		mergeSortPingPongFunc(src, dst, t, cmp)
+		return
+	}
+
+	mid := len(dst) / 2
+
+	var g TaskGroup
+	w.Spawn(&g, func(w *Worker) {
+		parallelMergeSortPingPongFunc(w, dst[:mid], src[:mid], totalSize, t, cmp)
+	})
+	parallelMergeSortPingPongFunc(w, dst[mid:], src[mid:], totalSize, t, cmp)
+
+	w.Wait(&g)
+	parallelMergeIntoFunc(w, src[:mid], src[mid:], dst, cmp)
+}
+
+// ParallelArgsort 병렬 인덱스 순열 정렬 (안정)
+func ParallelArgsort[T cmp.Ordered](keys []T) []int {
+	return ParallelArgsortFunc(keys, cmp.Compare[T])
+}
+
+// ParallelArgsortFunc 병렬 인덱스 순열 정렬 (비교 함수 버전)
+func ParallelArgsortFunc[T any](keys []T, cmp func(a, b T) int) []int {
+	perm := identityPermutation(len(keys))
+	ParallelStableSortFunc(perm, func(i, j int) int { return cmp(keys[i], keys[j]) })
+	return perm
+}
+
+// ParallelSortByKey 병렬로 키/값 슬라이스 함께 정렬 (안정)
+// 인덱스 순열을 병렬 정렬한 뒤 키와 값을 구간별로 나눠 동시에 재배치합니다.
+func ParallelSortByKey[K cmp.Ordered, V any](keys []K, values []V) {
+	if len(keys) != len(values) {
+		panic("sorts: ParallelSortByKey 키와 값의 길이가 다름")
+	}
+	parallelPermutePair(keys, values, ParallelArgsort(keys))
+}
+
+// ParallelSortByKeyFunc 병렬 키/값 슬라이스 정렬 (비교 함수 버전)
+func ParallelSortByKeyFunc[K, V any](keys []K, values []V, cmp func(a, b K) int) {
+	if len(keys) != len(values) {
+		panic("sorts: ParallelSortByKeyFunc 키와 값의 길이가 다름")
+	}
+	parallelPermutePair(keys, values, ParallelArgsortFunc(keys, cmp))
+}
+
+// parallelPermutePair keys 와 values 를 같은 순열로 병렬 재배치
+func parallelPermutePair[K, V any](keys []K, values []V, perm []int) {
+	if len(perm) < parallelMergeThreshold {
+		permute(keys, perm)
+		permute(values, perm)
+		return
+	}
+
+	DefaultScheduler().Run(func(w *Worker) {
+		var g TaskGroup
+		w.Spawn(&g, func(w *Worker) { parallelPermute(w, values, perm) })
+		parallelPermute(w, keys, perm)
+		w.Wait(&g)
+	})
+}
+
+// parallelPermute arr 를 perm 순서로 재배치 (구간별로 나눠 모은 뒤 복사)
+func parallelPermute[T any](w *Worker, arr []T, perm []int) {
+	n := len(arr)
+	tmp := make([]T, n)
+	segments := mergeSegmentCount(w, n)
+	parallelFor(w, segments, func(_ *Worker, s int) {
+		lo, hi := chunkBounds(n, segments, s)
+		for i := lo; i < hi; i++ {
+			tmp[i] = arr[perm[i]]
+		}
+	})
+	parallelCopy(w, arr, tmp)
+}
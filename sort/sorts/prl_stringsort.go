@@ -0,0 +1,139 @@
+package sorts
+
+// 병렬 문자열 정렬에서 부분 문제를 태스크로 넘길 최소 크기
+const parallelKeyThreshold = 2048
+
+// ParallelMultikeyQuickSort 병렬 3-way 기수 퀵소트
+// 분할 후 작음/큼 구간은 태스크로 넘기고 같음 구간은 다음 바이트로 직접 이어갑니다.
+func ParallelMultikeyQuickSort[K Key](keys []K) {
+	if len(keys) < parallelKeyThreshold {
+		MultikeyQuickSort(keys)
+		return
+	}
+
+	DefaultScheduler().Run(func(w *Worker) {
+		var g TaskGroup
+		parallelMultikeyQuickSort(w, &g, keys, 0)
+		w.Wait(&g)
+	})
+}
+
+func parallelMultikeyQuickSort[K Key](w *Worker, g *TaskGroup, a []K, d int) {
+	for len(a) >= parallelKeyThreshold {
+		lt, gt, v := partitionKeys(a, d)
+
+		spawnMultikey(w, g, a[:lt], d)
+		spawnMultikey(w, g, a[gt:], d)
+
+		if v < 0 {
+			return
+		}
+		a, d = a[lt:gt], d+1
+	}
+	multikeyQuickSort(a, d)
+}
+
+// spawnMultikey 큰 구간은 태스크로, 작은 구간은 바로 순차 정렬
+func spawnMultikey[K Key](w *Worker, g *TaskGroup, a []K, d int) {
+	if len(a) < parallelKeyThreshold {
+		multikeyQuickSort(a, d)
+		return
+	}
+	w.Spawn(g, func(w *Worker) {
+		parallelMultikeyQuickSort(w, g, a, d)
+	})
+}
+
+// ParallelMSDRadixSort 병렬 MSD 기수정렬
+// 큰 구간의 분배는 청크별 개수 세기/분산을 병렬로 하고, 버킷들은 태스크로 동시에 정렬합니다.
+func ParallelMSDRadixSort[K Key](keys []K) {
+	if len(keys) < parallelKeyThreshold {
+		MSDRadixSort(keys)
+		return
+	}
+
+	buf := make([]K, len(keys))
+	DefaultScheduler().Run(func(w *Worker) {
+		var g TaskGroup
+		parallelMSDRadixSort(w, &g, keys, buf, 0)
+		w.Wait(&g)
+	})
+}
+
+func parallelMSDRadixSort[K Key](w *Worker, g *TaskGroup, a, buf []K, d int) {
+	n := len(a)
+	if n < parallelKeyThreshold {
+		msdRadixSort(a, buf, d)
+		return
+	}
+
+	chunks := sampleChunkCount(n, w.s)
+	counts := make([][]int, chunks)
+	for {
+		parallelFor(w, chunks, func(_ *Worker, c int) {
+			lo, hi := chunkBounds(n, chunks, c)
+			count := make([]int, msdBuckets)
+			for _, k := range a[lo:hi] {
+				count[charAt(k, d)+1]++
+			}
+			counts[c] = count
+		})
+
+		// 공통 접두사 - 분배 생략
+		b, ok := singleBucketChunks(counts, n)
+		if !ok {
+			break
+		}
+		if b == 0 {
+			return
+		}
+		d++
+	}
+
+	bounds := samplePrefix(counts, msdBuckets)
+	parallelFor(w, chunks, func(_ *Worker, c int) {
+		lo, hi := chunkBounds(n, chunks, c)
+		offset := counts[c]
+		for _, k := range a[lo:hi] {
+			b := charAt(k, d) + 1
+			buf[offset[b]] = k
+			offset[b]++
+		}
+	})
+	parallelFor(w, chunks, func(_ *Worker, c int) {
+		lo, hi := chunkBounds(n, chunks, c)
+		copy(a[lo:hi], buf[lo:hi])
+	})
+
+	// 버킷 0 (키 끝) 은 이미 정렬됨
+	for b := 1; b < msdBuckets; b++ {
+		lo, hi := bounds[b], bounds[b+1]
+		if hi-lo < 2 {
+			continue
+		}
+		if hi-lo < parallelKeyThreshold {
+			msdRadixSort(a[lo:hi], buf[lo:hi], d+1)
+			continue
+		}
+		w.Spawn(g, func(w *Worker) {
+			parallelMSDRadixSort(w, g, a[lo:hi], buf[lo:hi], d+1)
+		})
+	}
+}
+
+// singleBucketChunks 청크별 개수를 합쳐 모든 키가 한 버킷에 있는지 확인
+func singleBucketChunks(counts [][]int, n int) (int, bool) {
+	for b := range msdBuckets {
+		total := 0
+		for c := range counts {
+			total += counts[c][b]
+		}
+		if total == n {
+			return b, true
+		}
+		if total > 0 {
+			return 0, false
+		}
+	}
+	return 0, false
+}
@@ -0,0 +1,129 @@
+package sorts
+
+import "cmp"
+
+// naturalChunkMin 병렬 자연 병합 정렬에서 청크당 최소 원소 수
+const naturalChunkMin = 1 << 14
+
+// ParallelNaturalMergeSort 병렬 자연 병합 정렬 (안정 정렬, 제자리 결과)
+// 배열을 워커 수만큼의 청크로 나눠 청크마다 런을 찾아 NaturalMergeSort 로 정렬한 뒤,
+// 이웃한 청크를 병렬 병합합니다. 병합할 때는 이미 제자리에 있는 앞뒤 부분을 건너뛰므로
+// 거의 정렬된 입력에서는 경계 근처의 몇 원소만 병합합니다.
+func ParallelNaturalMergeSort[T cmp.Ordered](arr []T) {
+	s := DefaultScheduler()
+	chunks := naturalChunkCount(len(arr), s)
+	if chunks < 2 {
+		NaturalMergeSort(arr)
+		return
+	}
+
+	buf := make([]T, len(arr))
+	s.Run(func(w *Worker) {
+		parallelNaturalMergeSort(w, arr, buf, chunks)
+	})
+}
+
+// naturalChunkCount 청크 수 (워커 수, 단 청크가 너무 작아지지 않도록)
+func naturalChunkCount(n int, s *Scheduler) int {
+	return max(1, min(s.NumWorkers(), n/naturalChunkMin))
+}
+
+// parallelNaturalMergeSort arr 를 chunks 개 청크로 보고 절반씩 나눠 정렬 후 병합
+// buf 는 arr 와 같은 크기의 보조 버퍼로, 청크 정렬과 병합이 겹치지 않는 구간을 나눠 씁니다.
+func parallelNaturalMergeSort[T cmp.Ordered](w *Worker, arr, buf []T, chunks int) {
+	if chunks == 1 {
+		naturalMergeSort(arr, buf)
+		return
+	}
+
+	half := chunks / 2
+	mid, _ := chunkBounds(len(arr), chunks, half)
+
+	var g TaskGroup
+	w.Spawn(&g, func(w *Worker) {
+		parallelNaturalMergeSort(w, arr[:mid], buf[:mid], half)
+	})
+	parallelNaturalMergeSort(w, arr[mid:], buf[mid:], chunks-half)
+	w.Wait(&g)
+
+	parallelMergeAdjacent(w, arr, buf, mid)
+}
+
+// parallelMergeAdjacent 정렬된 arr[:mid] 와 arr[mid:] 를 제자리 병합
+// arr[mid] 이하인 앞쪽 원소와 arr[mid-1] 이상인 뒤쪽 원소는 이미 제자리이므로
+// 그 사이만 buf 로 옮겨 병렬 병합합니다.
+func parallelMergeAdjacent[T cmp.Ordered](w *Worker, arr, buf []T, mid int) {
+	if arr[mid-1] <= arr[mid] {
+		return
+	}
+
+	first, last := arr[mid], arr[mid-1]
+	lo := gallop(arr[:mid], 0, func(v T) bool { return v <= first })
+	hi := mid + gallop(arr[mid:], 0, func(v T) bool { return v < last })
+
+	parallelCopy(w, buf[lo:hi], arr[lo:hi])
+	parallelMergeInto(w, buf[lo:mid], buf[mid:hi], arr[lo:hi])
+}
+
+// parallelCopy 큰 복사는 병합 구간 단위로 나눠 병렬 처리
+func parallelCopy[T any](w *Worker, dst, src []T) {
+	n := len(src)
+	segments := mergeSegmentCount(w, n)
+	if n < parallelMergeThreshold || segments < 2 {
+		copy(dst, src)
+		return
+	}
+
+	parallelFor(w, segments, func(_ *Worker, s int) {
+		lo, hi := chunkBounds(n, segments, s)
+		copy(dst[lo:hi], src[lo:hi])
+	})
+}
+
+// ParallelNaturalMergeSortFunc 병렬 자연 병합 정렬 (비교 함수 버전)
+func ParallelNaturalMergeSortFunc[T any](arr []T, cmp func(a, b T) int) {
+	s := DefaultScheduler()
+	chunks := naturalChunkCount(len(arr), s)
+	if chunks < 2 {
+		NaturalMergeSortFunc(arr, cmp)
+		return
+	}
+
+	buf := make([]T, len(arr))
+	s.Run(func(w *Worker) {
+		parallelNaturalMergeSortFunc(w, arr, buf, chunks, cmp)
+	})
+}
+
+func parallelNaturalMergeSortFunc[T any](w *Worker, arr, buf []T, chunks int, cmp func(a, b T) int) {
+	if chunks == 1 {
+		naturalMergeSortFunc(arr, buf, cmp)
+		return
+	}
+
+	half := chunks / 2
+	mid, _ := chunkBounds(len(arr), chunks, half)
+
+	var g TaskGroup
+	w.Spawn(&g, func(w *Worker) {
+		parallelNaturalMergeSortFunc(w, arr[:mid], buf[:mid], half, cmp)
+	})
+	parallelNaturalMergeSortFunc(w, arr[mid:], buf[mid:], chunks-half, cmp)
+	w.Wait(&g)
+
+	parallelMergeAdjacentFunc(w, arr, buf, mid, cmp)
+}
+
+// parallelMergeAdjacentFunc 이웃한 정렬 구간 병합 (비교 함수 버전)
+func parallelMergeAdjacentFunc[T any](w *Worker, arr, buf []T, mid int, cmp func(a, b T) int) {
+	if cmp(arr[mid-1], arr[mid]) <= 0 {
+		return
+	}
+
+	first, last := arr[mid], arr[mid-1]
+	lo := gallop(arr[:mid], 0, func(v T) bool { return cmp(v, first) <= 0 })
+	hi := mid + gallop(arr[mid:], 0, func(v T) bool { return cmp(v, last) < 0 })
+
+	parallelCopy(w, buf[lo:hi], arr[lo:hi])
+	parallelMergeIntoFunc(w, buf[lo:mid], buf[mid:hi], arr[lo:hi], cmp)
+}
@@ -0,0 +1,123 @@
+// Package sorts 는 벤치마크에서 사용하는 정렬 알고리즘(퀵소트, 머지소트와
+// 각각의 병렬 버전)을 제네릭 라이브러리로 제공합니다.
+//
+// 모든 알고리즘은 cmp.Ordered 타입을 위한 기본 함수와, 임의 타입을 위한
+// 비교 함수(func(a, b T) int) 버전(...Func)을 함께 제공합니다.
+// 부동소수점 NaN 의 정렬 순서는 정의되지 않습니다.
+package sorts
+
+import "cmp"
+
+// QuickSort 최적화 (하이브리드 접근)
+func QuickSort[T cmp.Ordered](arr []T) {
+	if len(arr) < 2 {
+		return
+	}
+	quickSortHelper(arr, 0, len(arr)-1, noDepthLimit, currentTuning())
+}
+
+// quickSortHelper depthLimit 번 분할한 뒤에도 정렬이 끝나지 않으면 힙정렬로 전환합니다
+// (인트로소트). depthLimit 이 음수(noDepthLimit)이면 깊이 제한이 없습니다.
+// t 는 진입점에서 한 번 읽은 임계값입니다 (재귀마다 다시 읽지 않음).
+func quickSortHelper[T cmp.Ordered](arr []T, low, high, depthLimit int, t *Tuning) {
+	cutoff, base := t.InsertionCutoff, t.BaseCase
+	for low < high {
+		size := high - low + 1
+
+		// 작은 배열에는 삽입정렬 또는 정렬 네트워크 사용 (더 빠름)
+		if size <= cutoff {
+			sortSmall(arr, low, high, base)
+			return
+		}
+
+		// 깊이 예산을 다 쓰면 O(n log n) 이 보장되는 힙정렬로 전환
+		if depthLimit == 0 {
+			heapSort(arr, low, high)
+			return
+		}
+		depthLimit--
+
+		// 3-way 파티셔닝으로 중복값 처리 최적화
+		lt, gt := partition3Way(arr, low, high)
+
+		// 꼬리 재귀 최적화 (더 작은 부분을 재귀로)
+		if lt-low < high-gt {
+			quickSortHelper(arr, low, lt-1, depthLimit, t)
+			low = gt + 1 // 꼬리 재귀 최적화
+		} else {
+			quickSortHelper(arr, gt+1, high, depthLimit, t)
+			high = lt - 1 // 꼬리 재귀 최적화
+		}
+	}
+}
+
+// 3-way 파티셔닝 (중복값 최적화)
+func partition3Way[T cmp.Ordered](arr []T, low, high int) (int, int) {
+	// 중앙값을 피벗으로 선택 (더 균형잡힌 분할)
+	medianOfThree(arr, low, (low+high)/2, high)
+	pivot := arr[low]
+
+	lt := low      // arr[low..lt-1] < pivot
+	i := low + 1   // arr[lt..i-1] == pivot
+	gt := high + 1 // arr[gt..high] > pivot
+
+	for i < gt {
+		if arr[i] < pivot {
+			arr[lt], arr[i] = arr[i], arr[lt]
+			lt++
+			i++
+		} else if arr[i] > pivot {
+			gt--
+			arr[i], arr[gt] = arr[gt], arr[i]
+		} else {
+			i++
+		}
+	}
+
+	return lt, gt - 1
+}
+
+// 중앙값 선택 (피벗 최적화)
+func medianOfThree[T cmp.Ordered](arr []T, a, b, c int) {
+	if arr[a] > arr[b] {
+		arr[a], arr[b] = arr[b], arr[a]
+	}
+	if arr[b] > arr[c] {
+		arr[b], arr[c] = arr[c], arr[b]
+	}
+	if arr[a] > arr[b] {
+		arr[a], arr[b] = arr[b], arr[a]
+	}
+	// 중앙값을 첫 번째 위치로
+	arr[a], arr[b] = arr[b], arr[a]
+}
+
+// 삽입정렬 (작은 배열 최적화)
+func insertionSort[T cmp.Ordered](arr []T, low, high int) {
+	for i := low + 1; i <= high; i++ {
+		key := arr[i]
+		j := i - 1
+
+		// 이진 삽입으로 최적화
+		for j >= low && arr[j] > key {
+			arr[j+1] = arr[j]
+			j--
+		}
+		arr[j+1] = key
+	}
+}
+
+// 기본 파티션 (호환성 유지)
+func partition[T cmp.Ordered](arr []T, low, high int) int {
+	pivot := arr[high]
+	i := low - 1
+
+	for j := low; j < high; j++ {
+		if arr[j] <= pivot {
+			i++
+			arr[i], arr[j] = arr[j], arr[i]
+		}
+	}
+	arr[i+1], arr[high] = arr[high], arr[i+1]
+	return i + 1
+}
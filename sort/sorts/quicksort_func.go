@@ -0,0 +1,94 @@
+package sorts
+
+// QuickSortFunc 비교 함수 기반 퀵소트
+// cmp(a, b) 는 a < b 이면 음수, a == b 이면 0, a > b 이면 양수를 반환해야 합니다.
+func QuickSortFunc[T any](arr []T, cmp func(a, b T) int) {
+	if len(arr) < 2 {
+		return
+	}
+	quickSortHelperFunc(arr, 0, len(arr)-1, noDepthLimit, currentTuning(), cmp)
+}
+
+func quickSortHelperFunc[T any](arr []T, low, high, depthLimit int, t *Tuning, cmp func(a, b T) int) {
+	cutoff := t.InsertionCutoff
+	for low < high {
+		size := high - low + 1
+
+		// 작은 배열에는 삽입정렬 사용
+		if size <= cutoff {
+			insertionSortFunc(arr, low, high, cmp)
+			return
+		}
+
+		// 깊이 예산을 다 쓰면 힙정렬로 전환
+		if depthLimit == 0 {
+			heapSortFunc(arr, low, high, cmp)
+			return
+		}
+		depthLimit--
+
+		lt, gt := partition3WayFunc(arr, low, high, cmp)
+
+		// 꼬리 재귀 최적화 (더 작은 부분을 재귀로)
+		if lt-low < high-gt {
+			quickSortHelperFunc(arr, low, lt-1, depthLimit, t, cmp)
+			low = gt + 1
+		} else {
+			quickSortHelperFunc(arr, gt+1, high, depthLimit, t, cmp)
+			high = lt - 1
+		}
+	}
+}
+
+// 3-way 파티셔닝 (비교 함수 버전)
+func partition3WayFunc[T any](arr []T, low, high int, cmp func(a, b T) int) (int, int) {
+	medianOfThreeFunc(arr, low, (low+high)/2, high, cmp)
+	pivot := arr[low]
+
+	lt := low      // arr[low..lt-1] < pivot
+	i := low + 1   // arr[lt..i-1] == pivot
+	gt := high + 1 // arr[gt..high] > pivot
+
+	for i < gt {
+		if c := cmp(arr[i], pivot); c < 0 {
+			arr[lt], arr[i] = arr[i], arr[lt]
+			lt++
+			i++
+		} else if c > 0 {
+			gt--
+			arr[i], arr[gt] = arr[gt], arr[i]
+		} else {
+			i++
+		}
+	}
+
+	return lt, gt - 1
+}
+
+// 중앙값 선택 (비교 함수 버전)
+func medianOfThreeFunc[T any](arr []T, a, b, c int, cmp func(a, b T) int) {
+	if cmp(arr[a], arr[b]) > 0 {
+		arr[a], arr[b] = arr[b], arr[a]
+	}
+	if cmp(arr[b], arr[c]) > 0 {
+		arr[b], arr[c] = arr[c], arr[b]
+	}
+	if cmp(arr[a], arr[b]) > 0 {
+		arr[a], arr[b] = arr[b], arr[a]
+	}
+	arr[a], arr[b] = arr[b], arr[a]
+}
+
+// 삽입정렬 (비교 함수 버전, 안정 정렬)
+func insertionSortFunc[T any](arr []T, low, high int, cmp func(a, b T) int) {
+	for i := low + 1; i <= high; i++ {
+		key := arr[i]
+		j := i - 1
+
+		for j >= low && cmp(arr[j], key) > 0 {
+			arr[j+1] = arr[j]
+			j--
+		}
+		arr[j+1] = key
+	}
+}
@@ -0,0 +1,122 @@
+package sorts
+
+import "unsafe"
+
+// Integer 기수정렬이 지원하는 정수 타입
+type Integer interface {
+	~int | ~int8 | ~int16 | ~int32 | ~int64 |
+		~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64 | ~uintptr
+}
+
+// 기수정렬 상수 (8비트 digit, 작은 배열은 삽입정렬)
+const (
+	radixBuckets = 256
+	radixCutoff  = 64
+)
+
+// RadixSort LSD 기수정렬 (8비트 digit, 안정 정렬)
+// 보조 버퍼를 한 번만 할당하고 매 패스마다 원본과 버퍼를 번갈아 사용합니다.
+// 모든 원소의 digit 이 같은 패스는 건너뜁니다.
+func RadixSort[T Integer](arr []T) {
+	n := len(arr)
+	if n < 2 {
+		return
+	}
+	if n <= radixCutoff {
+		insertionSort(arr, 0, n-1)
+		return
+	}
+
+	width, signFlip := integerLayout[T]()
+	src, dst := arr, make([]T, n)
+
+	for pass := range width {
+		shift := uint(pass * 8)
+		flip := byte(0)
+		if pass == width-1 {
+			flip = signFlip // 부호 있는 정수는 최상위 digit 의 부호 비트를 뒤집음
+		}
+
+		var count [radixBuckets]int
+		for _, v := range src {
+			count[byte(uint64(v)>>shift)^flip]++
+		}
+		if count[byte(uint64(src[0])>>shift)^flip] == n {
+			continue // 모든 원소가 같은 digit - 패스 생략
+		}
+
+		// 누적합으로 시작 위치 계산
+		sum := 0
+		for d := range count {
+			count[d], sum = sum, sum+count[d]
+		}
+
+		for _, v := range src {
+			d := byte(uint64(v)>>shift) ^ flip
+			dst[count[d]] = v
+			count[d]++
+		}
+		src, dst = dst, src
+	}
+
+	// 결과가 보조 버퍼에 있으면 원본으로 복사
+	if &src[0] != &arr[0] {
+		copy(arr, src)
+	}
+}
+
+// integerLayout 타입의 바이트 폭과 최상위 digit 에 적용할 부호 보정 값
+func integerLayout[T Integer]() (width int, signFlip byte) {
+	var zero T
+	width = int(unsafe.Sizeof(zero))
+	if ^zero < 0 {
+		signFlip = 0x80
+	}
+	return width, signFlip
+}
+
+// RadixSortBytes 고정 폭 바이트 키 LSD 기수정렬 (안정 정렬)
+// width 보다 짧은 키는 뒤쪽이 0 으로 채워진 것으로 간주합니다
+// (kvdb 의 generateKey 가 만드는 키와 같은 형태).
+func RadixSortBytes(keys [][]byte, width int) {
+	n := len(keys)
+	if n < 2 || width <= 0 {
+		return
+	}
+
+	src, dst := keys, make([][]byte, n)
+
+	for pos := width - 1; pos >= 0; pos-- {
+		var count [radixBuckets]int
+		for _, k := range src {
+			count[keyByte(k, pos)]++
+		}
+		if count[keyByte(src[0], pos)] == n {
+			continue
+		}
+
+		sum := 0
+		for d := range count {
+			count[d], sum = sum, sum+count[d]
+		}
+
+		for _, k := range src {
+			d := keyByte(k, pos)
+			dst[count[d]] = k
+			count[d]++
+		}
+		src, dst = dst, src
+	}
+
+	if &src[0] != &keys[0] {
+		copy(keys, src)
+	}
+}
+
+// keyByte pos 위치의 바이트 (범위를 벗어나면 0)
+func keyByte(k []byte, pos int) byte {
+	if pos < len(k) {
+		return k[pos]
+	}
+	return 0
+}
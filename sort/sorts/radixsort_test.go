@@ -0,0 +1,83 @@
+package sorts
+
+import (
+	"bytes"
+	"math"
+	"math/rand"
+	"slices"
+	"testing"
+)
+
+// checkRadixSort 순차/병렬 기수정렬 결과가 slices.Sort 와 같은지 확인
+func checkRadixSort[T Integer](t *testing.T, name string, data []T) {
+	t.Helper()
+	want := slices.Clone(data)
+	slices.Sort(want)
+
+	for algo, sort := range map[string]func([]T){
+		"radixsort":          RadixSort[T],
+		"parallel_radixsort": ParallelRadixSort[T],
+	} {
+		got := slices.Clone(data)
+		sort(got)
+		if !slices.Equal(got, want) {
+			t.Errorf("%s/%s: 결과가 slices.Sort 와 다름 (n=%d)", algo, name, len(data))
+		}
+	}
+}
+
+// 병렬 경로(parallelRadixThreshold 이상)와 부호 있는 타입, 패스 생략 경로를 함께 검사
+func TestRadixSort(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	for _, n := range []int{0, 1, radixCutoff, radixCutoff + 1, 1000, parallelRadixThreshold, 50000} {
+		ints := make([]int, n)
+		i8 := make([]int8, n)
+		u64 := make([]uint64, n)
+		i32 := make([]int32, n)
+		narrow := make([]int64, n) // 하위 바이트만 다름 - 상위 패스는 모두 생략
+		for i := range n {
+			ints[i] = rng.Int() - math.MaxInt/2
+			i8[i] = int8(rng.Intn(256) - 128)
+			u64[i] = rng.Uint64()
+			i32[i] = int32(rng.Intn(100)) - 50
+			narrow[i] = -1000 + int64(rng.Intn(200))
+		}
+		if n > 2 {
+			ints[0], ints[1] = math.MinInt, math.MaxInt
+		}
+		checkRadixSort(t, "int", ints)
+		checkRadixSort(t, "int8", i8)
+		checkRadixSort(t, "uint64", u64)
+		checkRadixSort(t, "int32 중복", i32)
+		checkRadixSort(t, "int64 좁은 범위", narrow)
+	}
+}
+
+func TestRadixSortBytes(t *testing.T) {
+	rng := rand.New(rand.NewSource(2))
+	const width = 6
+	for _, n := range []int{0, 1, 100, parallelRadixThreshold + 1, 30000} {
+		keys := make([][]byte, n)
+		for i := range keys {
+			keys[i] = make([]byte, width)
+			rng.Read(keys[i][2:]) // 앞 두 바이트는 공통 접두사
+			keys[i][width-1] %= 4 // 중복 키
+		}
+		want := slices.Clone(keys)
+		slices.SortStableFunc(want, bytes.Compare)
+
+		for name, sort := range map[string]func([][]byte, int){
+			"radixsort_bytes":          RadixSortBytes,
+			"parallel_radixsort_bytes": ParallelRadixSortBytes,
+		} {
+			got := slices.Clone(keys)
+			sort(got, width)
+			for i := range got {
+				// 같은 키는 원래 순서를 유지해야 하므로 슬라이스 자체가 같아야 함
+				if &got[i][0] != &want[i][0] {
+					t.Fatalf("%s: n=%d 의 %d 번째 키 %x, 기대 %x (정렬 또는 안정성 오류)", name, n, i, got[i], want[i])
+				}
+			}
+		}
+	}
+}
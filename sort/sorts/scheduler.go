@@ -0,0 +1,542 @@
+package sorts
+
+import (
+	"context"
+	"fmt"
+	"runtime"
+	"runtime/debug"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// ====================================================================================
+// 워크 스틸링 스케줄러
+// ====================================================================================
+//
+// 고정된 수의 장수(long-lived) 워커가 각자 덱(deque)을 가지고,
+// 자기 덱은 아래쪽(LIFO)에서 꺼내고 일이 없으면 다른 워커 덱의 위쪽(FIFO)에서 훔쳐옵니다.
+// 병렬 정렬은 분할마다 고루틴을 새로 만드는 대신 부분 구간을 태스크로 덱에 넣습니다.
+//
+// 태스크에서 발생한 패닉은 워커를 죽이지 않고 Run 을 호출한 고루틴에서 다시 발생합니다.
+// 패닉이나 컨텍스트 취소가 일어나면 같은 Run 에 속한 태스크들은 새로 시작하지 않고,
+// 진행 중인 태스크는 Wait 에서 (자식 태스크가 모두 끝난 뒤) 중단됩니다.
+
+// Task 워커에서 실행되는 작업 단위
+type Task func(w *Worker)
+
+// TaskGroup 자식 태스크들의 완료를 기다리기 위한 카운터
+type TaskGroup struct {
+	pending atomic.Int64
+}
+
+// SchedulerStats 스케줄러 누적 통계
+type SchedulerStats struct {
+	Workers  int           `json:"workers"`
+	Tasks    int64         `json:"tasks"`     // 실행한 태스크 수
+	Steals   int64         `json:"steals"`    // 다른 워커 덱에서 훔쳐온 태스크 수
+	IdleTime time.Duration `json:"idle_time"` // 워커들이 일 없이 대기한 누적 시간
+}
+
+// Sub 두 스냅샷 사이의 변화량 (구간 측정용)
+func (s SchedulerStats) Sub(prev SchedulerStats) SchedulerStats {
+	return SchedulerStats{
+		Workers:  s.Workers,
+		Tasks:    s.Tasks - prev.Tasks,
+		Steals:   s.Steals - prev.Steals,
+		IdleTime: s.IdleTime - prev.IdleTime,
+	}
+}
+
+// Scheduler 워크 스틸링 스케줄러
+type Scheduler struct {
+	workers []*Worker
+	inject  taskDeque // 워커 밖에서 제출된 태스크
+
+	// 대기 중인 워커 깨우기용
+	mu       sync.Mutex
+	cond     *sync.Cond
+	sleeping atomic.Int32
+	pending  atomic.Int64 // 덱에 쌓여 있는 태스크 수
+	closed   atomic.Bool  // 잠들기 전 양보 구간에서도 확인하도록 원자적으로 둠
+
+	// Run 수명 관리: Close 는 새 Run 을 막고(closing) 진행 중인 Run 이 모두 끝난 뒤 워커를 종료
+	activeRuns int
+	closing    bool
+	runsDone   *sync.Cond
+	retired    atomic.Bool // SetParallelism 으로 교체된 공용 스케줄러 (새 Run 은 현재 공용 스케줄러로 보냄)
+
+	wg sync.WaitGroup
+
+	tasks    atomic.Int64
+	steals   atomic.Int64
+	idleNano atomic.Int64
+}
+
+// Worker 스케줄러의 워커 (태스크 안에서 자식 태스크를 만들 때 사용)
+type Worker struct {
+	id        int
+	s         *Scheduler
+	dq        taskDeque
+	rng       uint64
+	idleSince atomic.Int64 // 대기 시작 시각 (UnixNano, 0 이면 작업 중)
+	st        *runState    // 지금 실행 중인 태스크가 속한 Run
+}
+
+// PanicError 병렬 태스크에서 발생한 패닉
+// Run 은 이 값으로 다시 panic 하고, RunContext 는 오류로 반환합니다.
+type PanicError struct {
+	Value any    // recover() 로 얻은 원래 값
+	Stack []byte // 패닉이 발생한 고루틴의 스택
+}
+
+func (e *PanicError) Error() string {
+	return fmt.Sprintf("sorts: 병렬 태스크 패닉: %v\n\n%s", e.Value, e.Stack)
+}
+
+// Unwrap 원래 패닉 값이 error 면 그 값 (errors.Is / errors.As 용)
+func (e *PanicError) Unwrap() error {
+	err, _ := e.Value.(error)
+	return err
+}
+
+// newPanicError recover 값을 PanicError 로 감쌈 (중첩된 Run 에서 이미 감싼 값은 그대로)
+func newPanicError(v any) *PanicError {
+	if pe, ok := v.(*PanicError); ok {
+		return pe
+	}
+	return &PanicError{Value: v, Stack: debug.Stack()}
+}
+
+// abortSignal 중단된 Run 의 태스크를 Wait 에서 빠져나오게 할 때 쓰는 panic 값
+type abortSignal struct{}
+
+// runState Run 한 번에 속한 태스크들이 공유하는 중단 상태
+type runState struct {
+	aborted atomic.Bool
+
+	mu       sync.Mutex
+	err      error // 첫 번째 중단 원인 (*PanicError 또는 컨텍스트 오류)
+	finished bool  // 루트 태스크가 끝난 뒤의 취소는 무시
+}
+
+// fail 첫 번째 중단 원인을 기록하고 남은 태스크들을 중단시킴
+func (st *runState) fail(err error) {
+	st.mu.Lock()
+	defer st.mu.Unlock()
+	if st.finished || st.err != nil {
+		return
+	}
+	st.err = err
+	st.aborted.Store(true)
+}
+
+// finish 루트 태스크 종료 후 중단 원인 반환
+func (st *runState) finish() error {
+	st.mu.Lock()
+	defer st.mu.Unlock()
+	st.finished = true
+	return st.err
+}
+
+// 기본 스케줄러 (병렬 정렬들이 공유)
+// SetParallelism 이 실행 중인 정렬과 동시에 교체할 수 있으므로 원자적으로 읽고 씁니다.
+var (
+	defaultScheduler     atomic.Pointer[Scheduler]
+	defaultSchedulerOnce sync.Once
+	parallelismMu        sync.Mutex // SetParallelism 직렬화
+)
+
+// DefaultScheduler CPU 코어 수만큼 워커를 가진 공용 스케줄러
+func DefaultScheduler() *Scheduler {
+	defaultSchedulerOnce.Do(func() {
+		defaultScheduler.Store(NewScheduler(runtime.NumCPU()))
+	})
+	return defaultScheduler.Load()
+}
+
+// SetParallelism 공용 스케줄러의 워커 수와 워커 풀 크기를 n 으로 변경 (n < 1 이면 CPU 코어 수)
+// GOMAXPROCS 를 바꿔 가며 측정할 때 병렬 정렬이 쓰는 고루틴 수도 맞추기 위한 것입니다.
+// 실행 중인 병렬 정렬과 동시에 호출해도 안전합니다. 이전 스케줄러에서 진행 중인 Run 은
+// 그대로 끝까지 실행되고, 이후의 Run 은 새 스케줄러에서 실행되며, 이전 스케줄러는
+// 진행 중인 Run 이 모두 끝난 뒤 닫힙니다 (그때까지 반환하지 않으므로 태스크 안에서는 호출하면 안 됨).
+func SetParallelism(n int) {
+	if n < 1 {
+		n = runtime.NumCPU()
+	}
+
+	parallelismMu.Lock()
+	defer parallelismMu.Unlock()
+	resizeWorkerPool(n)
+
+	s := DefaultScheduler()
+	if s.NumWorkers() == n {
+		return
+	}
+	defaultScheduler.Store(NewScheduler(n))
+	s.retired.Store(true)
+	s.Close()
+}
+
+// NewScheduler numWorkers 개의 워커를 띄운 스케줄러 생성
+func NewScheduler(numWorkers int) *Scheduler {
+	if numWorkers < 1 {
+		numWorkers = 1
+	}
+
+	s := &Scheduler{}
+	s.cond = sync.NewCond(&s.mu)
+	s.runsDone = sync.NewCond(&s.mu)
+	s.workers = make([]*Worker, numWorkers)
+	for i := range s.workers {
+		s.workers[i] = &Worker{id: i, s: s, rng: uint64(i)*0x9E3779B97F4A7C15 + 1}
+	}
+
+	s.wg.Add(numWorkers)
+	for _, w := range s.workers {
+		go w.loop()
+	}
+	return s
+}
+
+// NumWorkers 워커 수
+func (s *Scheduler) NumWorkers() int {
+	return len(s.workers)
+}
+
+// Run 루트 태스크를 제출하고 끝날 때까지 기다립니다.
+// 태스크 안에서는 Run 대신 Worker.Spawn / Worker.Wait 를 사용해야 합니다.
+// 어느 태스크에서든 패닉이 나면 모든 태스크가 멈춘 뒤 *PanicError 로 다시 panic 합니다.
+func (s *Scheduler) Run(fn Task) {
+	if err := s.run(nil, fn); err != nil {
+		panic(err)
+	}
+}
+
+// RunContext ctx 가 취소되면 남은 태스크를 중단하는 Run
+// 취소되면 ctx.Err() 를, 태스크에서 패닉이 나면 *PanicError 를 반환합니다.
+// 중단된 경우 태스크가 다루던 데이터는 중간 상태로 남습니다.
+func (s *Scheduler) RunContext(ctx context.Context, fn Task) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	return s.run(ctx, fn)
+}
+
+func (s *Scheduler) run(ctx context.Context, fn Task) error {
+	if !s.acquireRun() {
+		// 교체된 공용 스케줄러를 잡고 있던 호출은 현재 공용 스케줄러에서 실행
+		if cur := DefaultScheduler(); s.retired.Load() && cur != s {
+			return cur.run(ctx, fn)
+		}
+		panic("sorts: 닫힌 스케줄러에서 Run 호출")
+	}
+	defer s.releaseRun()
+
+	st := &runState{}
+	if ctx != nil {
+		stop := context.AfterFunc(ctx, func() { st.fail(ctx.Err()) })
+		defer stop()
+	}
+
+	done := make(chan struct{})
+	s.inject.pushBottom(func(w *Worker) {
+		defer close(done)
+		w.runTask(st, fn)
+	})
+	s.notify()
+	<-done
+	return st.finish()
+}
+
+// acquireRun 닫히는 중이 아니면 진행 중인 Run 으로 등록
+func (s *Scheduler) acquireRun() bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.closing {
+		return false
+	}
+	s.activeRuns++
+	return true
+}
+
+func (s *Scheduler) releaseRun() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.activeRuns--
+	if s.activeRuns == 0 && s.closing {
+		s.runsDone.Broadcast()
+	}
+}
+
+// Close 새 Run 을 막고, 진행 중인 Run 이 모두 끝나면 워커들을 종료한 뒤 모두 끝날 때까지 기다립니다.
+func (s *Scheduler) Close() {
+	s.mu.Lock()
+	s.closing = true
+	for s.activeRuns > 0 {
+		s.runsDone.Wait()
+	}
+	s.closed.Store(true)
+	s.cond.Broadcast()
+	s.mu.Unlock()
+	s.wg.Wait()
+}
+
+// Stats 현재까지의 누적 통계 (대기 중인 워커의 진행 중 유휴 시간 포함)
+func (s *Scheduler) Stats() SchedulerStats {
+	idle := s.idleNano.Load()
+	now := time.Now().UnixNano()
+	for _, w := range s.workers {
+		if since := w.idleSince.Load(); since != 0 {
+			idle += now - since
+		}
+	}
+
+	return SchedulerStats{
+		Workers:  len(s.workers),
+		Tasks:    s.tasks.Load(),
+		Steals:   s.steals.Load(),
+		IdleTime: time.Duration(idle),
+	}
+}
+
+// notify 태스크가 추가되었음을 알리고 잠든 워커가 있으면 하나 깨움
+func (s *Scheduler) notify() {
+	s.pending.Add(1)
+	if s.sleeping.Load() > 0 {
+		s.mu.Lock()
+		s.cond.Signal()
+		s.mu.Unlock()
+	}
+}
+
+// Spawn 자식 태스크를 자기 덱에 넣습니다 (다른 워커가 훔쳐갈 수 있음).
+func (w *Worker) Spawn(g *TaskGroup, fn Task) {
+	st := w.st
+	g.pending.Add(1)
+	w.dq.pushBottom(func(w *Worker) {
+		defer g.pending.Add(-1)
+		w.runTask(st, fn)
+	})
+	w.s.notify()
+}
+
+// Wait 그룹의 태스크가 모두 끝날 때까지 다른 태스크를 대신 실행하며 기다립니다.
+// 워커가 블록되지 않으므로 고정된 워커 수로도 교착 상태가 생기지 않습니다.
+// 그 사이 Run 이 중단되었으면 (자식들이 모두 끝난 뒤) 호출한 태스크도 중단합니다.
+// 훔칠 태스크가 없어 양보만 하는 동안은 park 와 마찬가지로 유휴 시간으로 셉니다.
+func (w *Worker) Wait(g *TaskGroup) {
+	var idleStart int64 // 0 이면 유휴 구간이 아님
+	for g.pending.Load() > 0 {
+		if t := w.findTask(); t != nil {
+			if idleStart != 0 {
+				w.endIdle(idleStart)
+				idleStart = 0
+			}
+			w.run(t)
+		} else {
+			if idleStart == 0 {
+				idleStart = w.beginIdle()
+			}
+			runtime.Gosched()
+		}
+	}
+	if idleStart != 0 {
+		w.endIdle(idleStart)
+	}
+	if w.aborted() {
+		panic(abortSignal{})
+	}
+}
+
+// aborted 지금 태스크가 속한 Run 이 패닉이나 취소로 중단되었는지
+// 오래 걸리는 루프는 Wait 까지 가지 않고 이 값으로 일찍 빠져나갈 수 있습니다.
+func (w *Worker) aborted() bool {
+	return w.st != nil && w.st.aborted.Load()
+}
+
+// runTask st 에 속한 태스크 실행
+// 패닉은 st 에 기록하고 삼키므로 워커 고루틴은 계속 동작합니다.
+func (w *Worker) runTask(st *runState, fn Task) {
+	prev := w.st
+	w.st = st
+	defer func() {
+		w.st = prev
+		if r := recover(); r != nil {
+			if _, ok := r.(abortSignal); !ok {
+				st.fail(newPanicError(r))
+			}
+		}
+	}()
+
+	if st.aborted.Load() {
+		return // 이미 중단된 Run 의 남은 태스크는 실행하지 않음
+	}
+	fn(w)
+}
+
+// parallelFor fn(0) ~ fn(n-1) 을 태스크로 나눠 실행하고 모두 끝날 때까지 대기
+// 첫 번째 작업은 호출한 워커가 직접 실행합니다. fn 은 그 작업을 실제로 실행하는 워커를 받으므로
+// 중단 여부(aborted)는 바깥 워커가 아닌 그 워커로 확인해야 합니다.
+func parallelFor(w *Worker, n int, fn func(w *Worker, i int)) {
+	var g TaskGroup
+	for i := 1; i < n; i++ {
+		w.Spawn(&g, func(w *Worker) { fn(w, i) })
+	}
+	if n > 0 {
+		fn(w, 0)
+	}
+	w.Wait(&g)
+}
+
+func (w *Worker) loop() {
+	defer w.s.wg.Done()
+
+	for {
+		if t := w.findTask(); t != nil {
+			w.run(t)
+			continue
+		}
+		if !w.park() {
+			return
+		}
+	}
+}
+
+func (w *Worker) run(t Task) {
+	w.s.tasks.Add(1)
+	t(w)
+}
+
+// findTask 자기 덱 → 외부 제출 큐 → 다른 워커 덱 순서로 태스크 탐색
+func (w *Worker) findTask() Task {
+	s := w.s
+	if s.pending.Load() <= 0 {
+		return nil
+	}
+
+	if t := w.dq.popBottom(); t != nil {
+		s.pending.Add(-1)
+		return t
+	}
+	if t := s.inject.stealTop(); t != nil {
+		s.pending.Add(-1)
+		return t
+	}
+
+	// 무작위 위치부터 한 바퀴 돌며 훔치기
+	n := len(s.workers)
+	start := int(w.nextRand() % uint64(n))
+	for i := range n {
+		victim := s.workers[(start+i)%n]
+		if victim == w {
+			continue
+		}
+		if t := victim.dq.stealTop(); t != nil {
+			s.pending.Add(-1)
+			s.steals.Add(1)
+			return t
+		}
+	}
+	return nil
+}
+
+// park 일이 생길 때까지 대기 (잠깐 양보하며 확인한 뒤 조건 변수로 잠듦)
+// 스케줄러가 닫혔으면 false 를 반환합니다.
+func (w *Worker) park() bool {
+	s := w.s
+	defer w.endIdle(w.beginIdle())
+
+	for range 64 {
+		if s.closed.Load() {
+			return false
+		}
+		if s.pending.Load() > 0 {
+			return true
+		}
+		runtime.Gosched()
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.sleeping.Add(1)
+	for s.pending.Load() <= 0 && !s.closed.Load() {
+		s.cond.Wait()
+	}
+	s.sleeping.Add(-1)
+	return !s.closed.Load()
+}
+
+// beginIdle 유휴 구간 시작 (진행 중인 유휴 시간은 Stats 가 idleSince 로 더함)
+func (w *Worker) beginIdle() int64 {
+	start := time.Now().UnixNano()
+	w.idleSince.Store(start)
+	return start
+}
+
+// endIdle beginIdle 로 시작한 유휴 구간을 끝내고 누적
+func (w *Worker) endIdle(start int64) {
+	w.idleSince.Store(0)
+	w.s.idleNano.Add(time.Now().UnixNano() - start)
+}
+
+// nextRand xorshift 난수 (훔칠 대상 선택용)
+func (w *Worker) nextRand() uint64 {
+	x := w.rng
+	x ^= x << 13
+	x ^= x >> 7
+	x ^= x << 17
+	w.rng = x
+	return x
+}
+
+// taskDeque 뮤텍스 기반 덱 (소유자는 아래쪽, 훔치는 쪽은 위쪽 사용)
+type taskDeque struct {
+	mu    sync.Mutex
+	items []Task
+	head  int
+}
+
+func (d *taskDeque) pushBottom(t Task) {
+	d.mu.Lock()
+	d.items = append(d.items, t)
+	d.mu.Unlock()
+}
+
+func (d *taskDeque) popBottom() Task {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	n := len(d.items)
+	if n == d.head {
+		return nil
+	}
+	t := d.items[n-1]
+	d.items[n-1] = nil
+	d.items = d.items[:n-1]
+	d.compact()
+	return t
+}
+
+func (d *taskDeque) stealTop() Task {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	if d.head == len(d.items) {
+		return nil
+	}
+	t := d.items[d.head]
+	d.items[d.head] = nil
+	d.head++
+	d.compact()
+	return t
+}
+
+// compact 비었으면 슬라이스를 처음부터 다시 사용
+func (d *taskDeque) compact() {
+	if d.head == len(d.items) {
+		d.items = d.items[:0]
+		d.head = 0
+	}
+}
@@ -0,0 +1,222 @@
+package sorts
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"math/rand"
+	"runtime"
+	"slices"
+	"sync/atomic"
+	"testing"
+)
+
+// mustPanicError fn 이 *PanicError 로 panic 하는지 확인하고 그 값을 반환
+func mustPanicError(t *testing.T, fn func()) (pe *PanicError) {
+	t.Helper()
+	defer func() {
+		r := recover()
+		var ok bool
+		if pe, ok = r.(*PanicError); !ok {
+			t.Fatalf("*PanicError 로 panic 해야 합니다: %#v", r)
+		}
+	}()
+	fn()
+	return nil
+}
+
+func TestSchedulerPanicPropagation(t *testing.T) {
+	s := NewScheduler(4)
+	defer s.Close()
+
+	pe := mustPanicError(t, func() {
+		s.Run(func(w *Worker) {
+			parallelFor(w, 16, func(_ *Worker, i int) {
+				if i == 11 {
+					panic("boom")
+				}
+			})
+		})
+	})
+	if pe.Value != "boom" || len(pe.Stack) == 0 {
+		t.Fatalf("패닉 값/스택이 전달되지 않음: %v", pe.Value)
+	}
+
+	// 패닉 뒤에도 워커들은 살아 있어야 함
+	var ran atomic.Int64
+	s.Run(func(w *Worker) {
+		parallelFor(w, 8, func(*Worker, int) { ran.Add(1) })
+	})
+	if ran.Load() != 8 {
+		t.Fatalf("패닉 뒤 태스크 실행 수 %d, 기대 8", ran.Load())
+	}
+}
+
+func TestSchedulerRunContextPanicAsError(t *testing.T) {
+	s := NewScheduler(2)
+	defer s.Close()
+
+	cause := errors.New("cause")
+	err := s.RunContext(context.Background(), func(w *Worker) {
+		var g TaskGroup
+		w.Spawn(&g, func(*Worker) { panic(cause) })
+		w.Wait(&g)
+		t.Error("중단된 Run 에서 Wait 뒤 코드가 실행됨")
+	})
+
+	var pe *PanicError
+	if !errors.As(err, &pe) || !errors.Is(err, cause) {
+		t.Fatalf("*PanicError(cause) 를 반환해야 합니다: %v", err)
+	}
+}
+
+func TestSchedulerRunContextCancel(t *testing.T) {
+	s := NewScheduler(4)
+	defer s.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	err := s.RunContext(ctx, func(w *Worker) {
+		parallelFor(w, 8, func(w *Worker, i int) {
+			if i == 3 {
+				cancel()
+			}
+			for !w.aborted() {
+				// 취소될 때까지 오래 걸리는 작업 흉내
+				runtime.Gosched()
+			}
+		})
+	})
+	if !errors.Is(err, context.Canceled) {
+		t.Fatalf("context.Canceled 를 반환해야 합니다: %v", err)
+	}
+
+	if err := s.RunContext(ctx, func(*Worker) { t.Error("취소된 ctx 로 태스크가 실행됨") }); !errors.Is(err, context.Canceled) {
+		t.Fatalf("이미 취소된 ctx: %v", err)
+	}
+}
+
+func TestParallelSortContext(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	arr := make([]int, 200000)
+	for i := range arr {
+		arr[i] = rng.Intn(1000)
+	}
+	want := slices.Clone(arr)
+	slices.Sort(want)
+
+	got := slices.Clone(arr)
+	if err := ParallelQuickSortContext(context.Background(), got); err != nil || !slices.Equal(got, want) {
+		t.Fatalf("ParallelQuickSortContext: err=%v, 정렬=%v", err, slices.Equal(got, want))
+	}
+	if merged, err := ParallelMergeSortContext(context.Background(), arr); err != nil || !slices.Equal(merged, want) {
+		t.Fatalf("ParallelMergeSortContext: err=%v", err)
+	}
+
+	// 취소되면 원소는 보존된 채 중단됨
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	got = slices.Clone(arr)
+	if err := ParallelPdqSortContext(ctx, got); !errors.Is(err, context.Canceled) {
+		t.Fatalf("ParallelPdqSortContext: %v", err)
+	}
+	slices.Sort(got)
+	if !slices.Equal(got, want) {
+		t.Fatal("취소된 정렬이 원소를 잃음")
+	}
+}
+
+func TestParallelSortComparatorPanic(t *testing.T) {
+	arr := make([]int, 100000)
+	for i := range arr {
+		arr[i] = len(arr) - i
+	}
+
+	// 자식 태스크의 비교 함수 패닉이 호출한 고루틴으로 전달되어야 함
+	var calls atomic.Int64
+	mustPanicError(t, func() {
+		ParallelQuickSortFunc(arr, func(a, b int) int {
+			if calls.Add(1) == 300000 {
+				panic("cmp")
+			}
+			return cmp.Compare(a, b)
+		})
+	})
+
+	// 퀵소트는 교환만 하므로 SafeParallelQuickSort 는 순차 정렬로 마무리할 수 있음
+	SafeParallelQuickSort(arr)
+	if !slices.IsSorted(arr) {
+		t.Fatal("SafeParallelQuickSort 결과가 정렬되지 않음")
+	}
+}
+
+func TestRunChunksPanicReleasesSlots(t *testing.T) {
+	InitWorkerPool()
+
+	mustPanicError(t, func() {
+		runChunks(cap(loadWorkerPool())+2, func(c int) {
+			if c == 1 {
+				panic("chunk")
+			}
+		})
+	})
+	if used, _ := WorkerPoolStatus(); used != 0 {
+		t.Fatalf("패닉 뒤 사용 중인 슬롯 %d", used)
+	}
+}
+
+// 병렬 정렬이 실행되는 동안 SetParallelism 으로 스케줄러와 워커 풀을 바꿔도
+// 정렬이 끝나고 결과가 올바른지 확인 (-race 로 실행하면 전역 교체의 경합도 검사)
+func TestSetParallelismConcurrentSorts(t *testing.T) {
+	defer SetParallelism(0)
+
+	rng := rand.New(rand.NewSource(3))
+	arr := make([]int, 20000)
+	for i := range arr {
+		arr[i] = rng.Intn(1000)
+	}
+	want := slices.Sorted(slices.Values(arr))
+
+	done := make(chan struct{})
+	errs := make(chan string, 4)
+	for g := range 4 {
+		go func() {
+			for {
+				select {
+				case <-done:
+					errs <- ""
+					return
+				default:
+				}
+				got := slices.Clone(arr)
+				if g%2 == 0 {
+					ParallelQuickSort(got)
+				} else {
+					ParallelRadixSort(got)
+				}
+				if !slices.Equal(got, want) {
+					errs <- "정렬 결과가 다름"
+					return
+				}
+			}
+		}()
+	}
+
+	for i := range 8 {
+		SetParallelism(1 + i%4)
+	}
+	close(done)
+	for range 4 {
+		if msg := <-errs; msg != "" {
+			t.Fatal(msg)
+		}
+	}
+
+	s := NewScheduler(2)
+	s.Close()
+	defer func() {
+		if recover() == nil {
+			t.Error("닫힌 스케줄러의 Run 이 panic 하지 않음")
+		}
+	}()
+	s.Run(func(*Worker) {})
+}
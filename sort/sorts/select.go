@@ -0,0 +1,94 @@
+package sorts
+
+import "cmp"
+
+// Select arr 을 재배치해 arr[k] 에 k 번째(0부터)로 작은 값을 두고 그 값을 반환합니다.
+// 결과적으로 arr[:k] 의 모든 값 <= arr[k] <= arr[k+1:] 의 모든 값이 됩니다 (각 구간 내부 순서는 정해지지 않음).
+// 퀵소트와 같은 partition3Way / medianOfThree 로 k 가 속한 쪽만 따라가는 퀵셀렉트이며,
+// 분할 횟수가 2·log2(n) 을 넘으면 남은 구간을 힙정렬해 최악에도 O(n log n) 입니다.
+// k 가 범위를 벗어나면 panic 합니다.
+func Select[T cmp.Ordered](arr []T, k int) T {
+	if k < 0 || k >= len(arr) {
+		panic("sorts: Select 인덱스 범위 초과")
+	}
+
+	low, high := 0, len(arr)-1
+	depthLimit := introDepthLimit(len(arr))
+	for high-low+1 > 16 {
+		if depthLimit == 0 {
+			heapSort(arr, low, high)
+			return arr[k]
+		}
+		depthLimit--
+
+		lt, gt := partition3Way(arr, low, high)
+		switch {
+		case k < lt:
+			high = lt - 1
+		case k > gt:
+			low = gt + 1
+		default:
+			return arr[k] // 피벗과 같은 구간
+		}
+	}
+
+	insertionSort(arr, low, high)
+	return arr[k]
+}
+
+// PartialSort 가장 작은 k 개를 정렬된 순서로 arr[:k] 에 둡니다 (나머지 순서는 정해지지 않음).
+// k 가 len(arr) 이상이면 전체를 정렬합니다.
+func PartialSort[T cmp.Ordered](arr []T, k int) {
+	if k <= 0 {
+		return
+	}
+	if k >= len(arr) {
+		IntroSort(arr)
+		return
+	}
+	Select(arr, k-1)
+	IntroSort(arr[:k-1])
+}
+
+// SelectFunc 비교 함수 기반 Select
+func SelectFunc[T any](arr []T, k int, cmp func(a, b T) int) T {
+	if k < 0 || k >= len(arr) {
+		panic("sorts: SelectFunc 인덱스 범위 초과")
+	}
+
+	low, high := 0, len(arr)-1
+	depthLimit := introDepthLimit(len(arr))
+	for high-low+1 > 16 {
+		if depthLimit == 0 {
+			heapSortFunc(arr, low, high, cmp)
+			return arr[k]
+		}
+		depthLimit--
+
+		lt, gt := partition3WayFunc(arr, low, high, cmp)
+		switch {
+		case k < lt:
+			high = lt - 1
+		case k > gt:
+			low = gt + 1
+		default:
+			return arr[k]
+		}
+	}
+
+	insertionSortFunc(arr, low, high, cmp)
+	return arr[k]
+}
+
+// PartialSortFunc 비교 함수 기반 PartialSort
+func PartialSortFunc[T any](arr []T, k int, cmp func(a, b T) int) {
+	if k <= 0 {
+		return
+	}
+	if k >= len(arr) {
+		IntroSortFunc(arr, cmp)
+		return
+	}
+	SelectFunc(arr, k-1, cmp)
+	IntroSortFunc(arr[:k-1], cmp)
+}
@@ -0,0 +1,97 @@
+package sorts
+
+import (
+	"cmp"
+	"math/rand"
+	"slices"
+	"testing"
+)
+
+// checkSelected arr 가 원본의 순열이고 arr[:k] <= arr[k] <= arr[k+1:] 이며 arr[k] 가 k 번째 값인지 확인
+func checkSelected(t *testing.T, name string, orig, arr []int, k, got int) {
+	t.Helper()
+	sorted := slices.Sorted(slices.Values(orig))
+	if got != sorted[k] || arr[k] != sorted[k] {
+		t.Fatalf("%s: n=%d k=%d 결과 %d (arr[k]=%d), 기대 %d", name, len(orig), k, got, arr[k], sorted[k])
+	}
+	for i, v := range arr {
+		if (i < k && v > arr[k]) || (i > k && v < arr[k]) {
+			t.Fatalf("%s: n=%d k=%d 에서 arr[%d]=%d 가 arr[k]=%d 의 잘못된 쪽에 있음", name, len(orig), k, i, v, arr[k])
+		}
+	}
+	if !slices.Equal(slices.Sorted(slices.Values(arr)), sorted) {
+		t.Fatalf("%s: n=%d k=%d 결과가 입력의 순열이 아님", name, len(orig), k)
+	}
+}
+
+func TestSelect(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	s := NewScheduler(4) // 코어가 하나여도 병렬 분할 경로를 검사
+	defer s.Close()
+
+	for _, n := range []int{1, 2, 17, 1000, parallelSelectThreshold * 3} {
+		for _, distinct := range []int{n, 10} {
+			orig := make([]int, n)
+			for i := range orig {
+				orig[i] = rng.Intn(distinct)
+			}
+			for _, k := range []int{0, n / 3, n - 1} {
+				arr := slices.Clone(orig)
+				checkSelected(t, "Select", orig, arr, k, Select(arr, k))
+
+				arr = slices.Clone(orig)
+				checkSelected(t, "SelectFunc", orig, arr, k, SelectFunc(arr, k, cmp.Compare[int]))
+
+				arr = slices.Clone(orig)
+				checkSelected(t, "ParallelNthElement", orig, arr, k, ParallelNthElement(arr, k))
+
+				if n >= parallelSelectThreshold {
+					arr = slices.Clone(orig)
+					checkSelected(t, "parallelNthElement", orig, arr, k, parallelNthElement(s, arr, k))
+
+					arr = slices.Clone(orig)
+					checkSelected(t, "parallelNthElementFunc", orig, arr, k, parallelNthElementFunc(s, arr, k, cmp.Compare[int]))
+				}
+			}
+		}
+	}
+}
+
+func TestPartialSortAndTopK(t *testing.T) {
+	rng := rand.New(rand.NewSource(2))
+	orig := make([]int, 5000)
+	for i := range orig {
+		orig[i] = rng.Intn(1000)
+	}
+	sorted := slices.Sorted(slices.Values(orig))
+
+	for _, k := range []int{0, 1, 16, 17, 100, len(orig), len(orig) + 5} {
+		n := min(k, len(orig))
+
+		for name, partial := range map[string]func([]int, int){
+			"PartialSort": PartialSort[int],
+			"PartialSortFunc": func(a []int, k int) {
+				PartialSortFunc(a, k, cmp.Compare[int])
+			},
+		} {
+			arr := slices.Clone(orig)
+			partial(arr, k)
+			if !slices.Equal(arr[:n], sorted[:n]) {
+				t.Errorf("%s k=%d: 앞 %d 개가 가장 작은 값들의 정렬 결과가 아님", name, k, n)
+			}
+			if !slices.Equal(slices.Sorted(slices.Values(arr)), sorted) {
+				t.Errorf("%s k=%d: 결과가 입력의 순열이 아님", name, k)
+			}
+		}
+
+		// TopK 는 가장 큰 k 개를 큰 순서대로
+		want := slices.Clone(sorted[len(sorted)-n:])
+		slices.Reverse(want)
+		if got := TopK(slices.Values(orig), k); !slices.Equal(got, want) {
+			t.Errorf("TopK k=%d: %v…, 기대 %v…", k, truncate(got), truncate(want))
+		}
+		if got := TopKFunc(slices.Values(orig), k, cmp.Compare[int]); !slices.Equal(got, want) {
+			t.Errorf("TopKFunc k=%d: %v…, 기대 %v…", k, truncate(got), truncate(want))
+		}
+	}
+}
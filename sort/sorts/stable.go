@@ -0,0 +1,83 @@
+package sorts
+
+import "cmp"
+
+// StableSortFunc 비교 함수 기반 안정 정렬 (제자리 결과)
+// 같은 값의 상대 순서를 유지하며, MergeSortBuffered 처럼 보조 버퍼를 한 번만 할당하는 머지소트입니다.
+func StableSortFunc[T any](arr []T, cmp func(a, b T) int) {
+	if len(arr) <= 1 {
+		return
+	}
+
+	buf := make([]T, len(arr))
+	copy(buf, arr)
+	mergeSortPingPongFunc(buf, arr, currentTuning(), cmp)
+}
+
+// mergeSortPingPongFunc 버퍼 머지소트 (비교 함수 버전)
+// 삽입정렬과 병합 모두 같은 값은 앞쪽을 먼저 두므로 안정 정렬입니다.
+func mergeSortPingPongFunc[T any](src, dst []T, t *Tuning, cmp func(a, b T) int) {
+	if len(dst) <= t.InsertionCutoff {
+		insertionSortFunc(dst, 0, len(dst)-1, cmp)
+		return
+	}
+
+	mid := len(dst) / 2
+	mergeSortPingPongFunc(dst[:mid], src[:mid], t, cmp)
+	mergeSortPingPongFunc(dst[mid:], src[mid:], t, cmp)
+
+	mergeIntoFunc(src[:mid], src[mid:], dst, cmp)
+}
+
+// Argsort keys 를 정렬하는 인덱스 순열 (keys 는 바꾸지 않음)
+// keys[perm[0]] <= keys[perm[1]] <= … 이며, 같은 키는 원래 인덱스 순서를 유지합니다.
+func Argsort[T cmp.Ordered](keys []T) []int {
+	return ArgsortFunc(keys, cmp.Compare[T])
+}
+
+// ArgsortFunc 인덱스 순열 정렬 (비교 함수 버전)
+func ArgsortFunc[T any](keys []T, cmp func(a, b T) int) []int {
+	perm := identityPermutation(len(keys))
+	StableSortFunc(perm, func(i, j int) int { return cmp(keys[i], keys[j]) })
+	return perm
+}
+
+// SortByKey keys 를 안정 정렬하면서 values 도 같은 순서로 재배치
+// 레코드를 구조체 배열 대신 키 슬라이스와 값 슬라이스로 나눠 둔(struct-of-arrays) 경우에 씁니다.
+// 길이가 다르면 panic 합니다.
+func SortByKey[K cmp.Ordered, V any](keys []K, values []V) {
+	if len(keys) != len(values) {
+		panic("sorts: SortByKey 키와 값의 길이가 다름")
+	}
+	perm := Argsort(keys)
+	permute(keys, perm)
+	permute(values, perm)
+}
+
+// SortByKeyFunc 키/값 슬라이스 함께 정렬 (비교 함수 버전)
+func SortByKeyFunc[K, V any](keys []K, values []V, cmp func(a, b K) int) {
+	if len(keys) != len(values) {
+		panic("sorts: SortByKeyFunc 키와 값의 길이가 다름")
+	}
+	perm := ArgsortFunc(keys, cmp)
+	permute(keys, perm)
+	permute(values, perm)
+}
+
+// identityPermutation 0, 1, …, n-1
+func identityPermutation(n int) []int {
+	perm := make([]int, n)
+	for i := range perm {
+		perm[i] = i
+	}
+	return perm
+}
+
+// permute arr 를 perm 순서로 재배치 (arr[i] = 원래의 arr[perm[i]])
+func permute[T any](arr []T, perm []int) {
+	tmp := make([]T, len(arr))
+	for i, p := range perm {
+		tmp[i] = arr[p]
+	}
+	copy(arr, tmp)
+}
@@ -0,0 +1,96 @@
+package sorts
+
+import (
+	"cmp"
+	"math/rand"
+	"slices"
+	"testing"
+)
+
+// record (키, ID) 레코드 - ID 는 입력 순서라서 같은 키끼리 ID 가 증가하면 안정 정렬
+type record struct {
+	key, id int
+}
+
+func compareRecordKeys(a, b record) int { return cmp.Compare(a.key, b.key) }
+
+// testRecords 중복 키가 많은 레코드 (ID 는 입력 순서)
+func testRecords(rng *rand.Rand, n, distinct int) []record {
+	recs := make([]record, n)
+	for i := range recs {
+		recs[i] = record{key: rng.Intn(distinct), id: i}
+	}
+	return recs
+}
+
+func TestStableSortFunc(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	for _, n := range []int{0, 1, 2, 17, 1000, 100000} {
+		for _, distinct := range []int{1, 10, n + 1} {
+			recs := testRecords(rng, n, distinct)
+			want := slices.Clone(recs)
+			slices.SortStableFunc(want, compareRecordKeys)
+
+			for name, sort := range map[string]func([]record, func(a, b record) int){
+				"StableSortFunc":               StableSortFunc[record],
+				"ParallelStableSortFunc":       ParallelStableSortFunc[record],
+				"NaturalMergeSortFunc":         NaturalMergeSortFunc[record],
+				"ParallelNaturalMergeSortFunc": ParallelNaturalMergeSortFunc[record],
+				// 머지소트는 정렬된 새 슬라이스를 돌려주므로 입력에 다시 복사
+				"MergeSortFunc": func(a []record, cmp func(a, b record) int) {
+					copy(a, MergeSortFunc(a, cmp))
+				},
+				"ParallelMergeSortFunc": func(a []record, cmp func(a, b record) int) {
+					copy(a, ParallelMergeSortFunc(a, cmp))
+				},
+			} {
+				got := slices.Clone(recs)
+				sort(got, compareRecordKeys)
+				if !slices.Equal(got, want) {
+					t.Fatalf("%s(n=%d, 키 %d종): 안정 정렬 결과와 다름", name, n, distinct)
+				}
+			}
+		}
+	}
+}
+
+func TestArgsortAndSortByKey(t *testing.T) {
+	rng := rand.New(rand.NewSource(2))
+	for _, n := range []int{0, 1, 33, 100000} {
+		keys := make([]int, n)
+		for i := range keys {
+			keys[i] = rng.Intn(50)
+		}
+		orig := slices.Clone(keys)
+
+		want := identityPermutation(n)
+		slices.SortStableFunc(want, func(i, j int) int { return cmp.Compare(keys[i], keys[j]) })
+		for name, perm := range map[string][]int{
+			"Argsort":         Argsort(keys),
+			"ParallelArgsort": ParallelArgsort(keys),
+		} {
+			if !slices.Equal(perm, want) {
+				t.Fatalf("%s(n=%d): 안정 인덱스 순열과 다름", name, n)
+			}
+		}
+		if !slices.Equal(keys, orig) {
+			t.Fatal("Argsort 가 keys 를 바꿈")
+		}
+
+		for name, sort := range map[string]func([]int, []int){
+			"SortByKey":         SortByKey[int, int],
+			"ParallelSortByKey": ParallelSortByKey[int, int],
+		} {
+			gotKeys, ids := slices.Clone(orig), identityPermutation(n)
+			sort(gotKeys, ids)
+			if !slices.Equal(ids, want) {
+				t.Fatalf("%s(n=%d): 값이 키와 같은 순서로 재배치되지 않음", name, n)
+			}
+			for i, id := range ids {
+				if gotKeys[i] != orig[id] {
+					t.Fatalf("%s(n=%d): 인덱스 %d 의 키 %d, 기대 %d", name, n, i, gotKeys[i], orig[id])
+				}
+			}
+		}
+	}
+}
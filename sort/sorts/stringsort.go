@@ -0,0 +1,161 @@
+package sorts
+
+// Key 바이트 단위로 비교하는 키 타입 ([]byte 또는 string)
+// 순서는 bytes.Compare / strings.Compare 와 같은 사전식 순서이며,
+// 한 키가 다른 키의 접두사이면 짧은 쪽이 앞에 옵니다.
+type Key interface {
+	~string | ~[]byte
+}
+
+// 문자열 정렬 설정
+const (
+	keyInsertionCutoff = 16  // 이 크기 이하 구간은 삽입정렬
+	msdBuckets         = 257 // 키 끝(0) + 바이트 값 256 개
+)
+
+// MultikeyQuickSort 3-way 기수 퀵소트 (Bentley–Sedgewick multikey quicksort)
+// 깊이 d 의 바이트 하나로 3-way 분할하고, 같은 구간만 다음 바이트로 넘어가므로
+// 공통 접두사가 긴 키(예: "0x..." 형태의 kvdb 키)도 접두사를 한 번씩만 비교합니다.
+func MultikeyQuickSort[K Key](keys []K) {
+	multikeyQuickSort(keys, 0)
+}
+
+func multikeyQuickSort[K Key](a []K, d int) {
+	for len(a) > keyInsertionCutoff {
+		lt, gt, v := partitionKeys(a, d)
+
+		multikeyQuickSort(a[:lt], d)
+		multikeyQuickSort(a[gt:], d)
+
+		// 가운데 구간은 깊이 d 의 바이트가 모두 같으므로 다음 바이트로 (꼬리 재귀 제거)
+		// v < 0 이면 모든 키가 여기서 끝나 서로 같습니다.
+		if v < 0 {
+			return
+		}
+		a, d = a[lt:gt], d+1
+	}
+	insertionSortKeys(a, d)
+}
+
+// partitionKeys 깊이 d 의 바이트 기준 3-way 분할
+// a[:lt] < v, a[lt:gt] == v, a[gt:] > v 가 되도록 재배치하고 lt, gt, 피벗 바이트 v 를 반환합니다.
+func partitionKeys[K Key](a []K, d int) (int, int, int) {
+	n := len(a)
+	v := medianOfThreeInts(charAt(a[0], d), charAt(a[n/2], d), charAt(a[n-1], d))
+
+	lt, i, gt := 0, 0, n
+	for i < gt {
+		switch c := charAt(a[i], d); {
+		case c < v:
+			a[lt], a[i] = a[i], a[lt]
+			lt++
+			i++
+		case c > v:
+			gt--
+			a[i], a[gt] = a[gt], a[i]
+		default:
+			i++
+		}
+	}
+	return lt, gt, v
+}
+
+// MSDRadixSort MSD 기수정렬 (작은 구간은 삽입정렬)
+// 깊이 d 의 바이트로 257 개 버킷(키 끝 + 256)에 분배한 뒤 각 버킷을 d+1 에서 재귀 정렬합니다.
+// 모든 키가 한 버킷에 들어가면 (공통 접두사) 분배 없이 바로 다음 바이트로 넘어갑니다.
+func MSDRadixSort[K Key](keys []K) {
+	if len(keys) < 2 {
+		return
+	}
+	msdRadixSort(keys, make([]K, len(keys)), 0)
+}
+
+func msdRadixSort[K Key](a, buf []K, d int) {
+	for len(a) > keyInsertionCutoff {
+		var count [msdBuckets + 1]int
+		for _, k := range a {
+			count[charAt(k, d)+2]++
+		}
+
+		// 공통 접두사 - 분배 생략
+		if b, ok := singleBucket(&count, len(a)); ok {
+			if b == 0 {
+				return // 모든 키가 여기서 끝남
+			}
+			d++
+			continue
+		}
+
+		for b := 1; b <= msdBuckets; b++ {
+			count[b] += count[b-1]
+		}
+		for _, k := range a {
+			c := charAt(k, d) + 1
+			buf[count[c]] = k
+			count[c]++
+		}
+		copy(a, buf[:len(a)])
+
+		// count[b] 는 이제 버킷 b 의 끝 (버킷 0 = 키 끝은 이미 정렬됨)
+		for b := 1; b < msdBuckets; b++ {
+			if lo, hi := count[b-1], count[b]; hi-lo > 1 {
+				msdRadixSort(a[lo:hi], buf, d+1)
+			}
+		}
+		return
+	}
+	insertionSortKeys(a, d)
+}
+
+// singleBucket 모든 키가 한 버킷에 있으면 그 버킷 번호
+// count 는 누적 전 상태로, 버킷 b (= charAt + 1) 의 개수가 count[b+1] 에 있습니다.
+func singleBucket(count *[msdBuckets + 1]int, n int) (int, bool) {
+	for b := range msdBuckets {
+		if count[b+1] == n {
+			return b, true
+		}
+	}
+	return 0, false
+}
+
+// charAt 깊이 d 의 바이트 (키가 끝났으면 -1)
+func charAt[K Key](k K, d int) int {
+	if d < len(k) {
+		return int(k[d])
+	}
+	return -1
+}
+
+// insertionSortKeys 앞 d 바이트가 모두 같은 키들의 삽입정렬
+func insertionSortKeys[K Key](a []K, d int) {
+	for i := 1; i < len(a); i++ {
+		key := a[i]
+		j := i - 1
+		for j >= 0 && keyLess(key, a[j], d) {
+			a[j+1] = a[j]
+			j--
+		}
+		a[j+1] = key
+	}
+}
+
+// keyLess 깊이 d 부터 비교해 a < b 인지
+func keyLess[K Key](a, b K, d int) bool {
+	n := min(len(a), len(b))
+	for i := d; i < n; i++ {
+		if a[i] != b[i] {
+			return a[i] < b[i]
+		}
+	}
+	return len(a) < len(b)
+}
+
+func medianOfThreeInts(a, b, c int) int {
+	if a > b {
+		a, b = b, a
+	}
+	if b > c {
+		b = c
+	}
+	return max(a, b)
+}
@@ -0,0 +1,383 @@
+package sorts
+
+import "cmp"
+
+// 자연 병합 정렬 (TimSort 방식) 설정
+const (
+	timMinMerge  = 64 // 이보다 짧은 배열은 이진 삽입정렬만 사용
+	timMinGallop = 7  // 한쪽이 연속으로 이만큼 이기면 갤로핑 모드로 전환
+)
+
+// NaturalMergeSort 적응형 자연 병합 정렬 (TimSort 방식, 안정 정렬, 제자리 결과)
+// 이미 있는 오름차순/내림차순 구간(런)을 찾아 그대로 쓰고, 짧은 런은 이진 삽입정렬로
+// minRun 길이까지 늘린 뒤 런 스택의 불변식에 따라 갤로핑 병합합니다.
+// 거의 정렬된 입력에서는 O(n) 에 가깝게 동작합니다.
+func NaturalMergeSort[T cmp.Ordered](arr []T) {
+	naturalMergeSort(arr, nil)
+}
+
+// naturalMergeSort buf 를 병합용 임시 버퍼로 사용 (모자라면 새로 할당)
+func naturalMergeSort[T cmp.Ordered](arr, buf []T) {
+	n := len(arr)
+	if n < 2 {
+		return
+	}
+
+	// 짧은 배열은 런 하나를 찾아 나머지를 이진 삽입정렬
+	if n < timMinMerge {
+		binaryInsertionSort(arr, countRunAndMakeAscending(arr))
+		return
+	}
+
+	ts := timSort[T]{a: arr, tmp: buf, minGallop: timMinGallop}
+	minRun := timMinRun(n)
+	for lo := 0; lo < n; {
+		runLen := countRunAndMakeAscending(arr[lo:])
+
+		// 짧은 런은 minRun 까지 늘림
+		if runLen < minRun {
+			force := min(n-lo, minRun)
+			binaryInsertionSort(arr[lo:lo+force], runLen)
+			runLen = force
+		}
+
+		ts.runs = append(ts.runs, timRun{lo, runLen})
+		ts.mergeCollapse()
+		lo += runLen
+	}
+	ts.mergeForceCollapse()
+}
+
+// timRun 런 스택의 한 항목 (arr[base:base+len])
+type timRun struct {
+	base, len int
+}
+
+// timSort 병합 상태 (런 스택, 임시 버퍼, 적응형 갤로핑 기준)
+type timSort[T cmp.Ordered] struct {
+	a         []T
+	tmp       []T
+	minGallop int
+	runs      []timRun
+}
+
+// timMinRun 런 최소 길이: n / minRun 이 2 의 거듭제곱에 가깝도록 [32, 64] 에서 선택
+func timMinRun(n int) int {
+	r := 0
+	for n >= timMinMerge {
+		r |= n & 1
+		n >>= 1
+	}
+	return n + r
+}
+
+// countRunAndMakeAscending arr 앞의 런 길이 (엄격한 내림차순 런은 뒤집어 오름차순으로 만듦)
+// 내림차순을 엄격하게 판단해야 뒤집어도 같은 값의 순서가 유지됩니다.
+func countRunAndMakeAscending[T cmp.Ordered](arr []T) int {
+	n := len(arr)
+	if n < 2 {
+		return n
+	}
+
+	i := 2
+	if arr[1] < arr[0] {
+		for i < n && arr[i] < arr[i-1] {
+			i++
+		}
+		reverse(arr[:i])
+	} else {
+		for i < n && arr[i] >= arr[i-1] {
+			i++
+		}
+	}
+	return i
+}
+
+func reverse[T any](arr []T) {
+	for i, j := 0, len(arr)-1; i < j; i, j = i+1, j-1 {
+		arr[i], arr[j] = arr[j], arr[i]
+	}
+}
+
+// binaryInsertionSort arr[:sorted] 가 정렬되어 있을 때 나머지를 이진 탐색으로 삽입 (안정)
+func binaryInsertionSort[T cmp.Ordered](arr []T, sorted int) {
+	for i := max(sorted, 1); i < len(arr); i++ {
+		pivot := arr[i]
+
+		// pivot 보다 큰 첫 위치 (같은 값 뒤에 삽입)
+		lo, hi := 0, i
+		for lo < hi {
+			mid := int(uint(lo+hi) >> 1)
+			if pivot < arr[mid] {
+				hi = mid
+			} else {
+				lo = mid + 1
+			}
+		}
+		copy(arr[lo+1:i+1], arr[lo:i])
+		arr[lo] = pivot
+	}
+}
+
+// mergeCollapse 런 스택 불변식이 성립할 때까지 병합
+// 위에서부터 길이가 A, B, C, D 일 때 B > C + D, C > D 를 유지합니다.
+// (원래 TimSort 의 검사는 한 단계 아래를 놓칠 수 있어 de Gouw 등의 수정판을 따름)
+func (ts *timSort[T]) mergeCollapse() {
+	for len(ts.runs) > 1 {
+		i := len(ts.runs) - 2
+		r := ts.runs
+		if i > 0 && r[i-1].len <= r[i].len+r[i+1].len || i > 1 && r[i-2].len <= r[i-1].len+r[i].len {
+			if r[i-1].len < r[i+1].len {
+				i--
+			}
+		} else if r[i].len > r[i+1].len {
+			break
+		}
+		ts.mergeAt(i)
+	}
+}
+
+// mergeForceCollapse 남은 런을 모두 병합
+func (ts *timSort[T]) mergeForceCollapse() {
+	for len(ts.runs) > 1 {
+		i := len(ts.runs) - 2
+		if i > 0 && ts.runs[i-1].len < ts.runs[i+1].len {
+			i--
+		}
+		ts.mergeAt(i)
+	}
+}
+
+// mergeAt 스택의 i, i+1 번째 런 병합
+// 이미 제자리에 있는 앞쪽(run2 의 첫 원소 이하)과 뒤쪽(run1 의 마지막 원소 이상)은 갤로핑으로 건너뜁니다.
+func (ts *timSort[T]) mergeAt(i int) {
+	base1, len1 := ts.runs[i].base, ts.runs[i].len
+	base2, len2 := ts.runs[i+1].base, ts.runs[i+1].len
+	ts.runs[i].len = len1 + len2
+	ts.runs = append(ts.runs[:i+1], ts.runs[i+2:]...)
+
+	a := ts.a
+	key := a[base2]
+	k := gallop(a[base1:base1+len1], 0, func(v T) bool { return v <= key })
+	base1 += k
+	len1 -= k
+	if len1 == 0 {
+		return
+	}
+
+	key = a[base1+len1-1]
+	len2 = gallop(a[base2:base2+len2], len2-1, func(v T) bool { return v < key })
+	if len2 == 0 {
+		return
+	}
+
+	if len1 <= len2 {
+		ts.mergeLo(base1, len1, base2, len2)
+	} else {
+		ts.mergeHi(base1, len1, base2, len2)
+	}
+}
+
+// ensureTmp n 개 이상의 임시 버퍼
+func (ts *timSort[T]) ensureTmp(n int) []T {
+	if len(ts.tmp) < n {
+		ts.tmp = make([]T, max(n, min(2*len(ts.tmp), len(ts.a)/2)))
+	}
+	return ts.tmp[:n]
+}
+
+// mergeLo 짧은 run1 을 임시 버퍼로 옮겨 앞에서부터 병합
+func (ts *timSort[T]) mergeLo(base1, len1, base2, len2 int) {
+	a := ts.a
+	tmp := ts.ensureTmp(len1)
+	copy(tmp, a[base1:base1+len1])
+
+	c1, c2, dest := 0, base2, base1
+	end2 := base2 + len2
+	minGallop := ts.minGallop
+
+outer:
+	for {
+		// 한 원소씩 비교하며 한쪽이 연속으로 이기는 횟수를 셈
+		count1, count2 := 0, 0
+		for count1 < minGallop && count2 < minGallop {
+			if a[c2] < tmp[c1] {
+				a[dest] = a[c2]
+				dest++
+				c2++
+				count1, count2 = 0, count2+1
+				if c2 == end2 {
+					break outer
+				}
+			} else {
+				a[dest] = tmp[c1]
+				dest++
+				c1++
+				count1, count2 = count1+1, 0
+				if c1 == len1 {
+					break outer
+				}
+			}
+		}
+
+		// 갤로핑 모드: 한쪽에서 한 번에 옮길 개수를 지수 탐색으로 찾음
+		for {
+			key := a[c2]
+			count1 = gallop(tmp[c1:len1], 0, func(v T) bool { return v <= key })
+			copy(a[dest:], tmp[c1:c1+count1])
+			dest += count1
+			c1 += count1
+			if c1 == len1 {
+				break outer
+			}
+			a[dest] = a[c2]
+			dest++
+			c2++
+			if c2 == end2 {
+				break outer
+			}
+
+			key = tmp[c1]
+			count2 = gallop(a[c2:end2], 0, func(v T) bool { return v < key })
+			copy(a[dest:], a[c2:c2+count2])
+			dest += count2
+			c2 += count2
+			if c2 == end2 {
+				break outer
+			}
+			a[dest] = tmp[c1]
+			dest++
+			c1++
+			if c1 == len1 {
+				break outer
+			}
+
+			// 갤로핑이 계속 이득이면 기준을 낮춤
+			minGallop--
+			if count1 < timMinGallop && count2 < timMinGallop {
+				break
+			}
+		}
+		minGallop = max(minGallop, 0) + 2
+	}
+	ts.minGallop = max(minGallop, 1)
+
+	// run2 가 먼저 끝났으면 남은 run1 을 뒤에 붙임 (run1 이 먼저 끝나면 run2 는 이미 제자리)
+	copy(a[dest:], tmp[c1:len1])
+}
+
+// mergeHi 짧은 run2 를 임시 버퍼로 옮겨 뒤에서부터 병합
+func (ts *timSort[T]) mergeHi(base1, len1, base2, len2 int) {
+	a := ts.a
+	tmp := ts.ensureTmp(len2)
+	copy(tmp, a[base2:base2+len2])
+
+	c1, c2, dest := base1+len1-1, len2-1, base2+len2-1
+	minGallop := ts.minGallop
+
+outer:
+	for {
+		count1, count2 := 0, 0
+		for count1 < minGallop && count2 < minGallop {
+			if tmp[c2] < a[c1] {
+				a[dest] = a[c1]
+				dest--
+				c1--
+				count1, count2 = count1+1, 0
+				if c1 < base1 {
+					break outer
+				}
+			} else {
+				a[dest] = tmp[c2]
+				dest--
+				c2--
+				count1, count2 = 0, count2+1
+				if c2 < 0 {
+					break outer
+				}
+			}
+		}
+
+		for {
+			// run1 에서 tmp[c2] 보다 큰 원소들은 그대로 뒤로 옮김
+			key := tmp[c2]
+			count1 = c1 + 1 - base1 - gallop(a[base1:c1+1], c1-base1, func(v T) bool { return v <= key })
+			dest -= count1
+			c1 -= count1
+			copy(a[dest+1:], a[c1+1:c1+1+count1])
+			if c1 < base1 {
+				break outer
+			}
+			a[dest] = tmp[c2]
+			dest--
+			c2--
+			if c2 < 0 {
+				break outer
+			}
+
+			// tmp 에서 a[c1] 이상인 원소들
+			key = a[c1]
+			count2 = c2 + 1 - gallop(tmp[:c2+1], c2, func(v T) bool { return v < key })
+			dest -= count2
+			c2 -= count2
+			copy(a[dest+1:], tmp[c2+1:c2+1+count2])
+			if c2 < 0 {
+				break outer
+			}
+			a[dest] = a[c1]
+			dest--
+			c1--
+			if c1 < base1 {
+				break outer
+			}
+
+			minGallop--
+			if count1 < timMinGallop && count2 < timMinGallop {
+				break
+			}
+		}
+		minGallop = max(minGallop, 0) + 2
+	}
+	ts.minGallop = max(minGallop, 1)
+
+	// run1 이 먼저 끝났으면 남은 tmp 를 앞에 채움
+	copy(a[dest-c2:dest+1], tmp[:c2+1])
+}
+
+// gallop before(a[i]) 가 참인 원소 수 (a 는 before 가 참인 원소들이 앞에 오도록 정렬됨)
+// hint 위치에서 1, 3, 7, ... 만큼 지수적으로 범위를 넓힌 뒤 그 안에서 이진 탐색합니다.
+// 답이 hint 가까이 있을 때 O(log 거리) 비교로 끝납니다.
+func gallop[T any](a []T, hint int, before func(T) bool) int {
+	lastOfs, ofs := 0, 1
+	if before(a[hint]) {
+		// 오른쪽으로: before(a[hint+lastOfs]) 이고 !before(a[hint+ofs])
+		maxOfs := len(a) - hint
+		for ofs < maxOfs && before(a[hint+ofs]) {
+			lastOfs = ofs
+			ofs = ofs<<1 + 1
+		}
+		ofs = min(ofs, maxOfs)
+		lastOfs, ofs = hint+lastOfs, hint+ofs
+	} else {
+		// 왼쪽으로: before(a[hint-ofs]) 이고 !before(a[hint-lastOfs])
+		maxOfs := hint + 1
+		for ofs < maxOfs && !before(a[hint-ofs]) {
+			lastOfs = ofs
+			ofs = ofs<<1 + 1
+		}
+		ofs = min(ofs, maxOfs)
+		lastOfs, ofs = hint-ofs, hint-lastOfs
+	}
+
+	// 답은 (lastOfs, ofs] 범위
+	lo, hi := lastOfs+1, ofs
+	for lo < hi {
+		mid := int(uint(lo+hi) >> 1)
+		if before(a[mid]) {
+			lo = mid + 1
+		} else {
+			hi = mid
+		}
+	}
+	return lo
+}
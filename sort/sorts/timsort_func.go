@@ -0,0 +1,310 @@
+package sorts
+
+// NaturalMergeSortFunc 적응형 자연 병합 정렬 (비교 함수 버전)
+func NaturalMergeSortFunc[T any](arr []T, cmp func(a, b T) int) {
+	naturalMergeSortFunc(arr, nil, cmp)
+}
+
+// naturalMergeSortFunc buf 를 병합용 임시 버퍼로 사용 (모자라면 새로 할당)
+func naturalMergeSortFunc[T any](arr, buf []T, cmp func(a, b T) int) {
+	n := len(arr)
+	if n < 2 {
+		return
+	}
+
+	// 짧은 배열은 런 하나를 찾아 나머지를 이진 삽입정렬
+	if n < timMinMerge {
+		binaryInsertionSortFunc(arr, countRunAndMakeAscendingFunc(arr, cmp), cmp)
+		return
+	}
+
+	ts := timSortFunc[T]{a: arr, tmp: buf, minGallop: timMinGallop, cmp: cmp}
+	minRun := timMinRun(n)
+	for lo := 0; lo < n; {
+		runLen := countRunAndMakeAscendingFunc(arr[lo:], cmp)
+
+		// 짧은 런은 minRun 까지 늘림
+		if runLen < minRun {
+			force := min(n-lo, minRun)
+			binaryInsertionSortFunc(arr[lo:lo+force], runLen, cmp)
+			runLen = force
+		}
+
+		ts.runs = append(ts.runs, timRun{lo, runLen})
+		ts.mergeCollapse()
+		lo += runLen
+	}
+	ts.mergeForceCollapse()
+}
+
+// timSortFunc 병합 상태 (비교 함수 버전)
+type timSortFunc[T any] struct {
+	a         []T
+	tmp       []T
+	minGallop int
+	runs      []timRun
+	cmp       func(a, b T) int
+}
+
+// countRunAndMakeAscendingFunc 런 길이 (비교 함수 버전)
+func countRunAndMakeAscendingFunc[T any](arr []T, cmp func(a, b T) int) int {
+	n := len(arr)
+	if n < 2 {
+		return n
+	}
+
+	i := 2
+	if cmp(arr[1], arr[0]) < 0 {
+		for i < n && cmp(arr[i], arr[i-1]) < 0 {
+			i++
+		}
+		reverse(arr[:i])
+	} else {
+		for i < n && cmp(arr[i], arr[i-1]) >= 0 {
+			i++
+		}
+	}
+	return i
+}
+
+// binaryInsertionSortFunc 이진 삽입정렬 (비교 함수 버전, 안정 정렬)
+func binaryInsertionSortFunc[T any](arr []T, sorted int, cmp func(a, b T) int) {
+	for i := max(sorted, 1); i < len(arr); i++ {
+		pivot := arr[i]
+
+		// pivot 보다 큰 첫 위치 (같은 값 뒤에 삽입)
+		lo, hi := 0, i
+		for lo < hi {
+			mid := int(uint(lo+hi) >> 1)
+			if cmp(pivot, arr[mid]) < 0 {
+				hi = mid
+			} else {
+				lo = mid + 1
+			}
+		}
+		copy(arr[lo+1:i+1], arr[lo:i])
+		arr[lo] = pivot
+	}
+}
+
+// mergeCollapse 런 스택 불변식 유지 (비교 함수 버전)
+func (ts *timSortFunc[T]) mergeCollapse() {
+	for len(ts.runs) > 1 {
+		i := len(ts.runs) - 2
+		r := ts.runs
+		if i > 0 && r[i-1].len <= r[i].len+r[i+1].len || i > 1 && r[i-2].len <= r[i-1].len+r[i].len {
+			if r[i-1].len < r[i+1].len {
+				i--
+			}
+		} else if r[i].len > r[i+1].len {
+			break
+		}
+		ts.mergeAt(i)
+	}
+}
+
+// mergeForceCollapse 남은 런을 모두 병합
+func (ts *timSortFunc[T]) mergeForceCollapse() {
+	for len(ts.runs) > 1 {
+		i := len(ts.runs) - 2
+		if i > 0 && ts.runs[i-1].len < ts.runs[i+1].len {
+			i--
+		}
+		ts.mergeAt(i)
+	}
+}
+
+// mergeAt 스택의 i, i+1 번째 런 병합 (비교 함수 버전)
+func (ts *timSortFunc[T]) mergeAt(i int) {
+	base1, len1 := ts.runs[i].base, ts.runs[i].len
+	base2, len2 := ts.runs[i+1].base, ts.runs[i+1].len
+	ts.runs[i].len = len1 + len2
+	ts.runs = append(ts.runs[:i+1], ts.runs[i+2:]...)
+
+	a := ts.a
+	key := a[base2]
+	k := gallop(a[base1:base1+len1], 0, func(v T) bool { return ts.cmp(v, key) <= 0 })
+	base1 += k
+	len1 -= k
+	if len1 == 0 {
+		return
+	}
+
+	key = a[base1+len1-1]
+	len2 = gallop(a[base2:base2+len2], len2-1, func(v T) bool { return ts.cmp(v, key) < 0 })
+	if len2 == 0 {
+		return
+	}
+
+	if len1 <= len2 {
+		ts.mergeLo(base1, len1, base2, len2)
+	} else {
+		ts.mergeHi(base1, len1, base2, len2)
+	}
+}
+
+// ensureTmp n 개 이상의 임시 버퍼
+func (ts *timSortFunc[T]) ensureTmp(n int) []T {
+	if len(ts.tmp) < n {
+		ts.tmp = make([]T, max(n, min(2*len(ts.tmp), len(ts.a)/2)))
+	}
+	return ts.tmp[:n]
+}
+
+// mergeLo 앞에서부터 갤로핑 병합 (비교 함수 버전)
+func (ts *timSortFunc[T]) mergeLo(base1, len1, base2, len2 int) {
+	a := ts.a
+	tmp := ts.ensureTmp(len1)
+	copy(tmp, a[base1:base1+len1])
+
+	c1, c2, dest := 0, base2, base1
+	end2 := base2 + len2
+	minGallop := ts.minGallop
+
+outer:
+	for {
+		// 한 원소씩 비교하며 한쪽이 연속으로 이기는 횟수를 셈
+		count1, count2 := 0, 0
+		for count1 < minGallop && count2 < minGallop {
+			if ts.cmp(a[c2], tmp[c1]) < 0 {
+				a[dest] = a[c2]
+				dest++
+				c2++
+				count1, count2 = 0, count2+1
+				if c2 == end2 {
+					break outer
+				}
+			} else {
+				a[dest] = tmp[c1]
+				dest++
+				c1++
+				count1, count2 = count1+1, 0
+				if c1 == len1 {
+					break outer
+				}
+			}
+		}
+
+		// 갤로핑 모드: 한쪽에서 한 번에 옮길 개수를 지수 탐색으로 찾음
+		for {
+			key := a[c2]
+			count1 = gallop(tmp[c1:len1], 0, func(v T) bool { return ts.cmp(v, key) <= 0 })
+			copy(a[dest:], tmp[c1:c1+count1])
+			dest += count1
+			c1 += count1
+			if c1 == len1 {
+				break outer
+			}
+			a[dest] = a[c2]
+			dest++
+			c2++
+			if c2 == end2 {
+				break outer
+			}
+
+			key = tmp[c1]
+			count2 = gallop(a[c2:end2], 0, func(v T) bool { return ts.cmp(v, key) < 0 })
+			copy(a[dest:], a[c2:c2+count2])
+			dest += count2
+			c2 += count2
+			if c2 == end2 {
+				break outer
+			}
+			a[dest] = tmp[c1]
+			dest++
+			c1++
+			if c1 == len1 {
+				break outer
+			}
+
+			// 갤로핑이 계속 이득이면 기준을 낮춤
+			minGallop--
+			if count1 < timMinGallop && count2 < timMinGallop {
+				break
+			}
+		}
+		minGallop = max(minGallop, 0) + 2
+	}
+	ts.minGallop = max(minGallop, 1)
+
+	// run2 가 먼저 끝났으면 남은 run1 을 뒤에 붙임 (run1 이 먼저 끝나면 run2 는 이미 제자리)
+	copy(a[dest:], tmp[c1:len1])
+}
+
+// mergeHi 뒤에서부터 갤로핑 병합 (비교 함수 버전)
+func (ts *timSortFunc[T]) mergeHi(base1, len1, base2, len2 int) {
+	a := ts.a
+	tmp := ts.ensureTmp(len2)
+	copy(tmp, a[base2:base2+len2])
+
+	c1, c2, dest := base1+len1-1, len2-1, base2+len2-1
+	minGallop := ts.minGallop
+
+outer:
+	for {
+		count1, count2 := 0, 0
+		for count1 < minGallop && count2 < minGallop {
+			if ts.cmp(tmp[c2], a[c1]) < 0 {
+				a[dest] = a[c1]
+				dest--
+				c1--
+				count1, count2 = count1+1, 0
+				if c1 < base1 {
+					break outer
+				}
+			} else {
+				a[dest] = tmp[c2]
+				dest--
+				c2--
+				count1, count2 = 0, count2+1
+				if c2 < 0 {
+					break outer
+				}
+			}
+		}
+
+		for {
+			// run1 에서 tmp[c2] 보다 큰 원소들은 그대로 뒤로 옮김
+			key := tmp[c2]
+			count1 = c1 + 1 - base1 - gallop(a[base1:c1+1], c1-base1, func(v T) bool { return ts.cmp(v, key) <= 0 })
+			dest -= count1
+			c1 -= count1
+			copy(a[dest+1:], a[c1+1:c1+1+count1])
+			if c1 < base1 {
+				break outer
+			}
+			a[dest] = tmp[c2]
+			dest--
+			c2--
+			if c2 < 0 {
+				break outer
+			}
+
+			// tmp 에서 a[c1] 이상인 원소들
+			key = a[c1]
+			count2 = c2 + 1 - gallop(tmp[:c2+1], c2, func(v T) bool { return ts.cmp(v, key) < 0 })
+			dest -= count2
+			c2 -= count2
+			copy(a[dest+1:], tmp[c2+1:c2+1+count2])
+			if c2 < 0 {
+				break outer
+			}
+			a[dest] = a[c1]
+			dest--
+			c1--
+			if c1 < base1 {
+				break outer
+			}
+
+			minGallop--
+			if count1 < timMinGallop && count2 < timMinGallop {
+				break
+			}
+		}
+		minGallop = max(minGallop, 0) + 2
+	}
+	ts.minGallop = max(minGallop, 1)
+
+	// run1 이 먼저 끝났으면 남은 tmp 를 앞에 채움
+	copy(a[dest-c2:dest+1], tmp[:c2+1])
+}
@@ -0,0 +1,115 @@
+package sorts
+
+import (
+	"cmp"
+	"iter"
+)
+
+// TopK 스트림에서 가장 큰 k 개를 큰 순서대로 반환합니다.
+// 크기 k 의 최소 힙만 유지하므로 스트림 길이와 무관하게 메모리는 O(k), 시간은 O(n log k) 입니다.
+func TopK[T cmp.Ordered](seq iter.Seq[T], k int) []T {
+	if k <= 0 {
+		return nil
+	}
+
+	heap := make([]T, 0, k)
+	for v := range seq {
+		if len(heap) < k {
+			heap = append(heap, v)
+			siftUpMin(heap, len(heap)-1)
+		} else if v > heap[0] {
+			heap[0] = v
+			siftDownMin(heap, 0, k)
+		}
+	}
+
+	// 최소 힙에서 가장 작은 값을 뒤로 보내면 내림차순이 됩니다.
+	for end := len(heap) - 1; end > 0; end-- {
+		heap[0], heap[end] = heap[end], heap[0]
+		siftDownMin(heap, 0, end)
+	}
+	return heap
+}
+
+// siftUpMin 최소 힙의 data[i] 를 위로 올림
+func siftUpMin[T cmp.Ordered](data []T, i int) {
+	for i > 0 {
+		parent := (i - 1) / 2
+		if !(data[i] < data[parent]) {
+			return
+		}
+		data[i], data[parent] = data[parent], data[i]
+		i = parent
+	}
+}
+
+// siftDownMin 최소 힙의 data[root] 를 아래로 내림 (크기 n)
+func siftDownMin[T cmp.Ordered](data []T, root, n int) {
+	for {
+		child := 2*root + 1
+		if child >= n {
+			return
+		}
+		if child+1 < n && data[child+1] < data[child] {
+			child++
+		}
+		if !(data[child] < data[root]) {
+			return
+		}
+		data[root], data[child] = data[child], data[root]
+		root = child
+	}
+}
+
+// TopKFunc 비교 함수 기준으로 가장 큰 k 개를 큰 순서대로 반환
+// 가장 작은 k 개가 필요하면 비교 함수의 부호를 뒤집어 넘기면 됩니다.
+func TopKFunc[T any](seq iter.Seq[T], k int, cmp func(a, b T) int) []T {
+	if k <= 0 {
+		return nil
+	}
+
+	heap := make([]T, 0, k)
+	for v := range seq {
+		if len(heap) < k {
+			heap = append(heap, v)
+			siftUpMinFunc(heap, len(heap)-1, cmp)
+		} else if cmp(v, heap[0]) > 0 {
+			heap[0] = v
+			siftDownMinFunc(heap, 0, k, cmp)
+		}
+	}
+
+	for end := len(heap) - 1; end > 0; end-- {
+		heap[0], heap[end] = heap[end], heap[0]
+		siftDownMinFunc(heap, 0, end, cmp)
+	}
+	return heap
+}
+
+func siftUpMinFunc[T any](data []T, i int, cmp func(a, b T) int) {
+	for i > 0 {
+		parent := (i - 1) / 2
+		if cmp(data[i], data[parent]) >= 0 {
+			return
+		}
+		data[i], data[parent] = data[parent], data[i]
+		i = parent
+	}
+}
+
+func siftDownMinFunc[T any](data []T, root, n int, cmp func(a, b T) int) {
+	for {
+		child := 2*root + 1
+		if child >= n {
+			return
+		}
+		if child+1 < n && cmp(data[child+1], data[child]) < 0 {
+			child++
+		}
+		if cmp(data[child], data[root]) >= 0 {
+			return
+		}
+		data[root], data[child] = data[child], data[root]
+		root = child
+	}
+}
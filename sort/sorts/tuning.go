@@ -0,0 +1,237 @@
+package sorts
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"runtime"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// 정렬 임계값 튜닝
+// 삽입정렬로 바꾸는 크기와 병렬 정렬이 태스크를 나누는 최소 구간 크기는 기계마다 최적값이 다릅니다.
+// 기본값은 16 코어 기계에서 고른 값이며, 벤치마크의 -calibrate 모드가 현재 기계에서 후보 값들을
+// 측정해 프로파일 파일로 저장합니다. 라이브러리는 사용자 설정 파일을 스스로 읽지 않으므로
+// (go test 결과가 기계 설정에 따라 달라지지 않도록) 프로그램이 시작할 때 LoadTuningProfile 로
+// 읽어야 하며, TuningProfileEnv 환경 변수를 지정한 경우에만 처음 정렬할 때 그 파일을 읽습니다.
+
+// 병렬 임계값이 적용되는 전체 크기 구간의 경계
+const (
+	TuningSmallMax  = 10000  // 전체 크기가 이보다 작으면 SmallThreshold
+	TuningMediumMax = 100000 // 전체 크기가 이보다 작으면 MediumThreshold, 그 이상은 LargeThreshold
+)
+
+// TuningProfileEnv 처음 정렬할 때 읽을 프로파일 경로를 지정하는 환경 변수 ("off" 면 읽지 않음)
+const TuningProfileEnv = "SORTS_TUNING_PROFILE"
+
+// BaseCase 퀵소트/머지소트가 InsertionCutoff 이하 구간을 정렬하는 방식
+type BaseCase string
+
+const (
+	BaseCaseInsertion BaseCase = "insertion" // 삽입정렬
+	BaseCaseNetwork   BaseCase = "network"   // 정렬 네트워크 (InsertionCutoff 가 MaxNetworkSize 이하일 때만)
+)
+
+// Tuning 순차/병렬 전환 임계값
+type Tuning struct {
+	// InsertionCutoff 이하 크기의 구간은 BaseCase 방식으로 정렬 (퀵소트, 머지소트)
+	InsertionCutoff int `json:"insertion_cutoff"`
+	// BaseCase 작은 구간의 정렬 방식 (빈 값은 삽입정렬)
+	// 비교 함수(...Func) 버전은 분기 없는 교환을 할 수 없고 안정성이 필요할 수 있어 항상 삽입정렬입니다.
+	BaseCase BaseCase `json:"base_case,omitempty"`
+	// ParallelMinSize 전체 크기가 이보다 작으면 병렬 처리하지 않음
+	ParallelMinSize int `json:"parallel_min_size"`
+	// 전체 크기 구간별로 태스크로 나누지 않고 순차 정렬하는 구간 크기
+	SmallThreshold  int `json:"small_threshold"`
+	MediumThreshold int `json:"medium_threshold"`
+	LargeThreshold  int `json:"large_threshold"`
+}
+
+// DefaultTuning 프로파일이 없을 때 쓰는 기본 임계값
+func DefaultTuning() Tuning {
+	return Tuning{
+		InsertionCutoff: 16,
+		BaseCase:        BaseCaseInsertion,
+		ParallelMinSize: 1000,
+		SmallThreshold:  300,
+		MediumThreshold: 800,
+		LargeThreshold:  1500,
+	}
+}
+
+// Validate 임계값이 정렬에 쓸 수 있는 범위인지 확인
+func (t Tuning) Validate() error {
+	switch {
+	case t.InsertionCutoff < 1:
+		return fmt.Errorf("insertion_cutoff 는 1 이상이어야 합니다: %d", t.InsertionCutoff)
+	case t.BaseCase != "" && t.BaseCase != BaseCaseInsertion && t.BaseCase != BaseCaseNetwork:
+		return fmt.Errorf("base_case 는 %q 또는 %q 이어야 합니다: %q", BaseCaseInsertion, BaseCaseNetwork, t.BaseCase)
+	case t.BaseCase == BaseCaseNetwork && t.InsertionCutoff > MaxNetworkSize:
+		return fmt.Errorf("정렬 네트워크는 %d개 이하 구간만 정렬할 수 있습니다: insertion_cutoff %d", MaxNetworkSize, t.InsertionCutoff)
+	case t.ParallelMinSize < 0:
+		return fmt.Errorf("parallel_min_size 는 0 이상이어야 합니다: %d", t.ParallelMinSize)
+	case t.SmallThreshold < 2 || t.MediumThreshold < 2 || t.LargeThreshold < 2:
+		return fmt.Errorf("병렬 임계값은 2 이상이어야 합니다: %d/%d/%d",
+			t.SmallThreshold, t.MediumThreshold, t.LargeThreshold)
+	}
+	return nil
+}
+
+// Threshold 전체 크기 totalSize 인 병렬 정렬에서 더 나누지 않고 순차 정렬하는 구간 크기
+func (t Tuning) Threshold(totalSize int) int {
+	switch {
+	case totalSize < t.ParallelMinSize:
+		return totalSize // 작은 데이터는 병렬처리 안함
+	case totalSize < TuningSmallMax:
+		return t.SmallThreshold
+	case totalSize < TuningMediumMax:
+		return t.MediumThreshold
+	default:
+		return t.LargeThreshold
+	}
+}
+
+// TuningProfile 보정 결과 파일 형식 (측정한 기계 정보 포함)
+type TuningProfile struct {
+	Tuning
+	CalibratedAt time.Time `json:"calibrated_at"`
+	GOOS         string    `json:"goos"`
+	GOARCH       string    `json:"goarch"`
+	NumCPU       int       `json:"num_cpu"`
+	GOMAXPROCS   int       `json:"gomaxprocs"`
+}
+
+// NewTuningProfile 현재 기계 정보로 t 의 프로파일 생성
+func NewTuningProfile(t Tuning) TuningProfile {
+	return TuningProfile{
+		Tuning:       t,
+		CalibratedAt: time.Now(),
+		GOOS:         runtime.GOOS,
+		GOARCH:       runtime.GOARCH,
+		NumCPU:       runtime.NumCPU(),
+		GOMAXPROCS:   runtime.GOMAXPROCS(0),
+	}
+}
+
+// 현재 임계값
+var (
+	tuning       atomic.Pointer[tuningState]
+	tuningLoaded sync.Once
+)
+
+type tuningState struct {
+	t      Tuning
+	source string // 프로파일 경로 (기본값이면 빈 문자열)
+}
+
+// currentTuning 정렬 함수들이 쓰는 임계값 (처음 호출할 때 환경 변수의 프로파일을 읽음)
+// 정렬 진입점에서 한 번만 읽어 재귀 단계로 넘깁니다. 저장된 값은 바꾸지 않고 교체만 하므로
+// 정렬 도중 SetTuning 이 불려도 그 정렬은 처음 읽은 값을 그대로 씁니다.
+func currentTuning() *Tuning {
+	tuningLoaded.Do(loadStartupProfile)
+	return &tuning.Load().t
+}
+
+// loadStartupProfile TuningProfileEnv 로 지정한 프로파일을 읽고, 없거나 실패하면 기본값 사용
+func loadStartupProfile() {
+	tuning.Store(&tuningState{t: DefaultTuning()})
+	if path := os.Getenv(TuningProfileEnv); path != "" && path != "off" {
+		if p, err := readTuningProfile(path); err == nil {
+			tuning.Store(&tuningState{t: p.Tuning, source: path})
+		}
+	}
+}
+
+// CurrentTuning 지금 사용 중인 임계값과 그 출처 (프로파일 경로, 기본값이면 빈 문자열)
+func CurrentTuning() (Tuning, string) {
+	t := currentTuning()
+	return *t, tuning.Load().source
+}
+
+// SetTuning 임계값 변경 (이후 시작하는 정렬부터 적용)
+// 처음 정렬하기 전에 호출하면 TuningProfileEnv 의 프로파일은 읽지 않습니다.
+func SetTuning(t Tuning) error {
+	return setTuning(t, "")
+}
+
+func setTuning(t Tuning, source string) error {
+	if err := t.Validate(); err != nil {
+		return err
+	}
+	tuningLoaded.Do(func() {})
+	tuning.Store(&tuningState{t: t, source: source})
+	return nil
+}
+
+// SetBaseCase 작은 구간 정렬 방식만 변경 (다른 임계값과 출처는 그대로)
+func SetBaseCase(b BaseCase) error {
+	t := *currentTuning()
+	t.BaseCase = b
+	return setTuning(t, tuning.Load().source)
+}
+
+// TuningProfilePath 프로그램이 시작할 때 LoadTuningProfile 로 읽을 기본 프로파일 경로
+// TuningProfileEnv 환경 변수가 있으면 그 값, 없으면 사용자 설정 디렉터리의
+// gotest-sort/tuning.json 입니다. 읽지 않아야 하면 빈 문자열을 반환합니다.
+// 라이브러리는 환경 변수가 없으면 이 경로를 스스로 읽지 않습니다.
+func TuningProfilePath() string {
+	if path, ok := os.LookupEnv(TuningProfileEnv); ok {
+		if path == "off" {
+			return ""
+		}
+		return path
+	}
+	dir, err := os.UserConfigDir()
+	if err != nil {
+		return ""
+	}
+	return filepath.Join(dir, "gotest-sort", "tuning.json")
+}
+
+// LoadTuningProfile path 의 프로파일을 읽어 적용
+func LoadTuningProfile(path string) (TuningProfile, error) {
+	p, err := readTuningProfile(path)
+	if err != nil {
+		return TuningProfile{}, err
+	}
+	return p, setTuning(p.Tuning, path)
+}
+
+func readTuningProfile(path string) (TuningProfile, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return TuningProfile{}, err
+	}
+
+	var p TuningProfile
+	if err := json.Unmarshal(data, &p); err != nil {
+		return TuningProfile{}, fmt.Errorf("튜닝 프로파일 %s 파싱 오류: %w", path, err)
+	}
+	if err := p.Validate(); err != nil {
+		return TuningProfile{}, fmt.Errorf("튜닝 프로파일 %s: %w", path, err)
+	}
+	return p, nil
+}
+
+// SaveTuningProfile 프로파일을 path 에 저장 (디렉터리가 없으면 만듦)
+func SaveTuningProfile(path string, p TuningProfile) error {
+	if err := p.Validate(); err != nil {
+		return err
+	}
+	if path == "" {
+		return errors.New("튜닝 프로파일 경로가 비어 있습니다")
+	}
+
+	data, err := json.MarshalIndent(p, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return err
+	}
+	return os.WriteFile(path, append(data, '\n'), 0o644)
+}
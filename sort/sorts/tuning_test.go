@@ -0,0 +1,99 @@
+package sorts
+
+import (
+	"cmp"
+	"math/rand"
+	"path/filepath"
+	"slices"
+	"testing"
+)
+
+func TestTuningProfileRoundTrip(t *testing.T) {
+	orig, _ := CurrentTuning()
+	defer SetTuning(orig)
+
+	want := Tuning{InsertionCutoff: 24, ParallelMinSize: 4096, SmallThreshold: 512, MediumThreshold: 1024, LargeThreshold: 4096}
+	path := filepath.Join(t.TempDir(), "sub", "tuning.json")
+	if err := SaveTuningProfile(path, NewTuningProfile(want)); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := LoadTuningProfile(path); err != nil {
+		t.Fatal(err)
+	}
+	if got, source := CurrentTuning(); got != want || source != path {
+		t.Fatalf("읽은 임계값 %+v (%s), 기대 %+v (%s)", got, source, want, path)
+	}
+
+	if err := SetTuning(Tuning{}); err == nil {
+		t.Fatal("0 임계값이 허용됨")
+	}
+}
+
+// 라이브러리는 사용자 설정 디렉터리의 프로파일을 스스로 읽지 않고 환경 변수로 지정한 경우에만 읽음
+func TestStartupProfileOptIn(t *testing.T) {
+	orig, _ := CurrentTuning()
+	defer SetTuning(orig)
+
+	dir := t.TempDir()
+	t.Setenv("HOME", dir)
+	t.Setenv("XDG_CONFIG_HOME", dir)
+	t.Setenv(TuningProfileEnv, "")
+	want := Tuning{InsertionCutoff: 24, ParallelMinSize: 4096, SmallThreshold: 512, MediumThreshold: 1024, LargeThreshold: 4096}
+	path := filepath.Join(dir, "gotest-sort", "tuning.json")
+	if err := SaveTuningProfile(path, NewTuningProfile(want)); err != nil {
+		t.Fatal(err)
+	}
+
+	loadStartupProfile()
+	if got, source := CurrentTuning(); got != DefaultTuning() || source != "" {
+		t.Fatalf("환경 변수 없이 %+v (%s) 를 읽음", got, source)
+	}
+
+	t.Setenv(TuningProfileEnv, path)
+	loadStartupProfile()
+	if got, source := CurrentTuning(); got != want || source != path {
+		t.Fatalf("환경 변수의 프로파일: %+v (%s), 기대 %+v (%s)", got, source, want, path)
+	}
+}
+
+// 극단적인 임계값에서도 모든 정렬이 올바른지 확인
+func TestTuningExtremes(t *testing.T) {
+	orig, _ := CurrentTuning()
+	defer SetTuning(orig)
+
+	rng := rand.New(rand.NewSource(1))
+	arr := make([]int, 20000)
+	for i := range arr {
+		arr[i] = rng.Intn(5000)
+	}
+
+	for _, tn := range []Tuning{
+		{InsertionCutoff: 1, ParallelMinSize: 0, SmallThreshold: 2, MediumThreshold: 2, LargeThreshold: 2},
+		{InsertionCutoff: 256, ParallelMinSize: 1 << 30, SmallThreshold: 2, MediumThreshold: 2, LargeThreshold: 2},
+	} {
+		if err := SetTuning(tn); err != nil {
+			t.Fatal(err)
+		}
+		for name, sort := range map[string]func([]int){
+			"quicksort": QuickSort[int],
+			"introsort": IntroSort[int],
+			"introsort_func": func(a []int) {
+				IntroSortFunc(a, cmp.Compare[int])
+			},
+			"parallel_introsort": ParallelIntroSort[int],
+			"mergesort_buffered": MergeSortBuffered[int],
+			"parallel_mergesort_buffered": func(a []int) {
+				ParallelMergeSortBuffered(a)
+			},
+			"parallel_mergesort": func(a []int) {
+				copy(a, ParallelMergeSort(a))
+			},
+		} {
+			got := slices.Clone(arr)
+			sort(got)
+			if !slices.IsSorted(got) {
+				t.Errorf("%s: %+v 에서 정렬되지 않음", name, tn)
+			}
+		}
+	}
+}
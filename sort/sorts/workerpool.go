@@ -0,0 +1,42 @@
+package sorts
+
+import (
+	"runtime"
+	"sync"
+	"sync/atomic"
+)
+
+// 전역 워커 풀 (재사용을 위해)
+// SetParallelism 이 실행 중인 정렬과 동시에 교체할 수 있으므로 원자적으로 읽고 씁니다.
+var (
+	workerPool     atomic.Pointer[chan struct{}]
+	workerPoolOnce sync.Once
+)
+
+// InitWorkerPool 워커 풀 초기화
+// * 채널 통한 세마포 구현. 병렬 정렬 함수들이 자동으로 호출하므로
+// * 라이브러리 사용자가 직접 호출할 필요는 없습니다.
+func InitWorkerPool() {
+	workerPoolOnce.Do(func() {
+		pool := make(chan struct{}, runtime.NumCPU())
+		workerPool.Store(&pool)
+	})
+}
+
+// loadWorkerPool 현재 워커 풀 (초기화 전이면 nil)
+func loadWorkerPool() chan struct{} {
+	if p := workerPool.Load(); p != nil {
+		return *p
+	}
+	return nil
+}
+
+// resizeWorkerPool 워커 풀을 크기 n 의 새 채널로 교체 (SetParallelism 이 잠금을 쥐고 호출)
+// 실행 중인 runChunks 는 시작할 때 잡은 채널에 슬롯을 반환하므로 영향을 받지 않습니다.
+func resizeWorkerPool(n int) {
+	InitWorkerPool()
+	if cap(loadWorkerPool()) != n {
+		pool := make(chan struct{}, n)
+		workerPool.Store(&pool)
+	}
+}
@@ -0,0 +1,132 @@
+package main
+
+import (
+	"fmt"
+	"hash/maphash"
+	"slices"
+
+	"gotest/sort/sorts"
+)
+
+// multisetFingerprint 순서와 무관한 원소 집합 지문
+// 정렬 결과가 입력의 순열인지(원소가 사라지거나 복제되지 않았는지) 확인하는 데 사용합니다.
+type multisetFingerprint struct {
+	Count int
+	Sum   uint64 // 오버플로는 2^64 로 순환
+	Xor   uint64
+	Hash  uint64 // 원소별 splitmix64 해시의 합
+}
+
+// fingerprintOf 데이터의 멀티셋 지문 계산
+func fingerprintOf(data []int) multisetFingerprint {
+	fp := multisetFingerprint{Count: len(data)}
+	for _, v := range data {
+		fp.add(v)
+	}
+	return fp
+}
+
+func (fp *multisetFingerprint) add(v int) {
+	u := uint64(v)
+	fp.Sum += u
+	fp.Xor ^= u
+	fp.Hash += mix64(u)
+}
+
+// mix64 splitmix64 종료 함수 (합에 넣었을 때 충돌이 잘 나지 않도록 비트를 섞음)
+func mix64(x uint64) uint64 {
+	x += 0x9E3779B97F4A7C15
+	x = (x ^ (x >> 30)) * 0xBF58476D1CE4E5B9
+	x = (x ^ (x >> 27)) * 0x94D049BB133111EB
+	return x ^ (x >> 31)
+}
+
+// keySeed 키 지문용 해시 시드 (한 프로세스 안에서만 비교하므로 실행마다 달라도 됨)
+var keySeed = maphash.MakeSeed()
+
+// keyFingerprintOf 키 목록의 멀티셋 지문 (키마다 해시 값을 원소로 사용)
+func keyFingerprintOf[K sorts.Key](keys []K) multisetFingerprint {
+	fp := multisetFingerprint{Count: len(keys)}
+	for _, key := range keys {
+		fp.add(int(maphash.String(keySeed, string(key))))
+	}
+	return fp
+}
+
+// verifyKeysSorted 키가 사전순이고 입력과 같은 멀티셋인지 검사
+func verifyKeysSorted[K sorts.Key](input multisetFingerprint, keys []K) error {
+	for i := 1; i < len(keys); i++ {
+		if string(keys[i-1]) > string(keys[i]) {
+			return fmt.Errorf("정렬되지 않음: 인덱스 %d (%q > %q)", i-1, keys[i-1], keys[i])
+		}
+	}
+
+	if got := keyFingerprintOf(keys); got != input {
+		return fmt.Errorf("입력의 순열이 아님: 키 수 %d→%d, 지문 %x/%x/%x → %x/%x/%x",
+			input.Count, got.Count, input.Sum, input.Xor, input.Hash, got.Sum, got.Xor, got.Hash)
+	}
+	return nil
+}
+
+// verifySelected 선택 결과가 가장 작은 k 개인지 검사
+// want 는 정렬된 입력의 앞 k 개이며, ordered 가 아니면 순서는 비교하지 않습니다.
+func verifySelected(want, got []int, ordered bool) error {
+	if len(got) != len(want) {
+		return fmt.Errorf("선택 개수가 다름: %d (기대 %d)", len(got), len(want))
+	}
+	if !ordered {
+		got = slices.Clone(got)
+		slices.Sort(got)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			return fmt.Errorf("가장 작은 k 개가 아님: 인덱스 %d (%d, 기대 %d)", i, got[i], want[i])
+		}
+	}
+	return nil
+}
+
+// verifySorted 결과가 오름차순이고 입력과 같은 멀티셋인지 검사
+func verifySorted(input multisetFingerprint, output []int) error {
+	for i := 1; i < len(output); i++ {
+		if output[i-1] > output[i] {
+			return fmt.Errorf("정렬되지 않음: 인덱스 %d (%d > %d)", i-1, output[i-1], output[i])
+		}
+	}
+
+	if got := fingerprintOf(output); got != input {
+		return fmt.Errorf("입력의 순열이 아님: 원소 수 %d→%d, 지문 %x/%x/%x → %x/%x/%x",
+			input.Count, got.Count, input.Sum, input.Xor, input.Hash, got.Sum, got.Xor, got.Hash)
+	}
+	return nil
+}
+
+// verifyRecordsStable (키, ID) 레코드가 안정 정렬되었는지 검사
+// ID 는 입력 인덱스이므로 input[id] 가 그 레코드의 원래 키입니다.
+// 키가 오름차순이고, 같은 키끼리는 ID 가 증가하며(안정성), ID 가 입력의 순열이어야 합니다.
+func verifyRecordsStable(input, keys, ids []int) error {
+	if len(keys) != len(input) || len(ids) != len(input) {
+		return fmt.Errorf("레코드 수가 다름: 키 %d, ID %d (기대 %d)", len(keys), len(ids), len(input))
+	}
+
+	seen := make([]bool, len(input))
+	for i, id := range ids {
+		if id < 0 || id >= len(input) || seen[id] {
+			return fmt.Errorf("입력의 순열이 아님: 인덱스 %d 의 ID %d", i, id)
+		}
+		seen[id] = true
+		if keys[i] != input[id] {
+			return fmt.Errorf("키와 ID 가 어긋남: 인덱스 %d 의 키 %d, ID %d 의 원래 키 %d", i, keys[i], id, input[id])
+		}
+		if i == 0 {
+			continue
+		}
+		if keys[i-1] > keys[i] {
+			return fmt.Errorf("정렬되지 않음: 인덱스 %d (%d > %d)", i-1, keys[i-1], keys[i])
+		}
+		if keys[i-1] == keys[i] && ids[i-1] > id {
+			return fmt.Errorf("안정 정렬이 아님: 인덱스 %d 의 같은 키 %d 에서 ID %d 가 %d 보다 앞", i-1, keys[i], ids[i-1], id)
+		}
+	}
+	return nil
+}